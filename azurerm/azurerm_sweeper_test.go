@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func TestMain(m *testing.M) {
@@ -46,7 +47,9 @@ func buildConfigForSweepers() (*ArmClient, error) {
 		return nil, fmt.Errorf("Error building ARM Client: %+v", err)
 	}
 
-	return getArmClient(config, false)
+	return getArmClient(config, armClientOptions{
+		retryPolicy: azure.DefaultRetryPolicy(),
+	})
 }
 
 func shouldSweepAcceptanceTestResource(name string, resourceLocation string, region string) bool {
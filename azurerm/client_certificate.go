@@ -0,0 +1,197 @@
+package azurerm
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// clientCertificateAuth authenticates as a Service Principal using a Client Certificate which isn't supported
+// by the authentication.Builder, which only supports PKCS#12 bundles with a `.pfx` extension
+type clientCertificateAuth struct {
+	clientId    string
+	certificate *x509.Certificate
+	privateKey  *rsa.PrivateKey
+}
+
+func (a clientCertificateAuth) GetAuthorizationToken(oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+	spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, a.clientId, a.certificate, a.privateKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// buildAuthenticationConfig builds the authentication.Config from the Builder - and when authenticating using a
+// Client Certificate which the Builder doesn't support (since it only supports *.pfx files) the token provider to use
+func buildAuthenticationConfig(b authentication.Builder) (*authentication.Config, authorizationTokenProvider, error) {
+	if b.SupportsClientCertAuth && b.ClientCertPath != "" && !isBuilderClientCertificate(b.ClientCertPath) {
+		config, auth, err := buildClientCertificateAuth(b)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return config, auth, nil
+	}
+
+	config, err := b.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return config, nil, nil
+}
+
+// isBuilderClientCertificate determines if the Client Certificate at the specified path is handled by the
+// authentication.Builder - rather than being decoded here (e.g. PEM-encoded certificates or *.p12 bundles)
+func isBuilderClientCertificate(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".pfx")
+}
+
+// buildClientCertificateAuth builds the authentication.Config and clientCertificateAuth used to authenticate
+// using the Client Certificate specified in the Builder
+func buildClientCertificateAuth(b authentication.Builder) (*authentication.Config, *clientCertificateAuth, error) {
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using a Client Certificate."
+	if b.SubscriptionID == "" {
+		return nil, nil, fmt.Errorf(fmtErrorMessage, "Subscription ID")
+	}
+	if b.ClientID == "" {
+		return nil, nil, fmt.Errorf(fmtErrorMessage, "Client ID")
+	}
+	if b.TenantID == "" {
+		return nil, nil, fmt.Errorf(fmtErrorMessage, "Tenant ID")
+	}
+
+	data, err := ioutil.ReadFile(b.ClientCertPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading Client Certificate %q: %+v", b.ClientCertPath, err)
+	}
+
+	certificate, privateKey, err := decodeClientCertificate(data, b.ClientCertPassword)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error decoding Client Certificate %q: %+v", b.ClientCertPath, err)
+	}
+
+	config := authentication.Config{
+		ClientID:                         b.ClientID,
+		SubscriptionID:                   b.SubscriptionID,
+		TenantID:                         b.TenantID,
+		Environment:                      b.Environment,
+		AuthenticatedAsAServicePrincipal: true,
+	}
+	auth := clientCertificateAuth{
+		clientId:    b.ClientID,
+		certificate: certificate,
+		privateKey:  privateKey,
+	}
+	return &config, &auth, nil
+}
+
+// decodeClientCertificate decodes a Client Certificate and its RSA Private Key, which can either be a PKCS#12
+// bundle or PEM-encoded (in which case the Private Key can optionally be encrypted using the password)
+func decodeClientCertificate(data []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	if !strings.Contains(string(data), "-----BEGIN") {
+		privateKey, certificate, err := pkcs12.Decode(data, password)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error decoding PKCS#12 bundle: %+v", err)
+		}
+
+		rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+		if !ok {
+			return nil, nil, fmt.Errorf("The Client Certificate must contain an RSA Private Key")
+		}
+
+		return certificate, rsaPrivateKey, nil
+	}
+
+	var certificate *x509.Certificate
+	var privateKey *rsa.PrivateKey
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			// the Client Certificate is the first certificate in the chain
+			if certificate != nil {
+				continue
+			}
+
+			parsed, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("Error parsing Certificate: %+v", err)
+			}
+			certificate = parsed
+
+		case "RSA PRIVATE KEY", "PRIVATE KEY":
+			parsed, err := parsePEMPrivateKey(block, password)
+			if err != nil {
+				return nil, nil, err
+			}
+			privateKey = parsed
+
+		case "ENCRYPTED PRIVATE KEY":
+			return nil, nil, fmt.Errorf("PKCS#8 encrypted Private Keys aren't supported - please use a PKCS#12 bundle or a PKCS#1 encrypted Private Key")
+		}
+	}
+
+	if certificate == nil {
+		return nil, nil, fmt.Errorf("No Certificate was found in the PEM data")
+	}
+
+	if privateKey == nil {
+		return nil, nil, fmt.Errorf("No Private Key was found in the PEM data")
+	}
+
+	return certificate, privateKey, nil
+}
+
+func parsePEMPrivateKey(block *pem.Block, password string) (*rsa.PrivateKey, error) {
+	data := block.Bytes
+
+	if x509.IsEncryptedPEMBlock(block) {
+		if password == "" {
+			return nil, fmt.Errorf("The Private Key is encrypted but no password was specified")
+		}
+
+		decrypted, err := x509.DecryptPEMBlock(block, []byte(password))
+		if err != nil {
+			return nil, fmt.Errorf("Error decrypting Private Key: %+v", err)
+		}
+		data = decrypted
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		privateKey, err := x509.ParsePKCS1PrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing PKCS#1 Private Key: %+v", err)
+		}
+
+		return privateKey, nil
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing PKCS#8 Private Key: %+v", err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("The Client Certificate must contain an RSA Private Key")
+	}
+
+	return rsaPrivateKey, nil
+}
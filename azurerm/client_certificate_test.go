@@ -0,0 +1,309 @@
+package azurerm
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func TestDecodeClientCertificate(t *testing.T) {
+	privateKey, certificate := generateTestClientCertificate(t)
+
+	certificateBlock := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})
+	pkcs1Block := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("Error marshalling PKCS#8 Private Key: %+v", err)
+	}
+	pkcs8Block := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})
+
+	encrypted, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(privateKey), []byte("terraform"), x509.PEMCipherAES256)
+	if err != nil {
+		t.Fatalf("Error encrypting Private Key: %+v", err)
+	}
+	encryptedBlock := pem.EncodeToMemory(encrypted)
+
+	testCases := []struct {
+		Name     string
+		Data     []byte
+		Password string
+		Error    bool
+	}{
+		{
+			Name: "PKCS#1 Private Key",
+			Data: bytes.Join([][]byte{certificateBlock, pkcs1Block}, nil),
+		},
+		{
+			Name: "PKCS#1 Private Key before the Certificate",
+			Data: bytes.Join([][]byte{pkcs1Block, certificateBlock}, nil),
+		},
+		{
+			Name: "PKCS#8 Private Key",
+			Data: bytes.Join([][]byte{certificateBlock, pkcs8Block}, nil),
+		},
+		{
+			Name:     "Encrypted Private Key",
+			Data:     bytes.Join([][]byte{certificateBlock, encryptedBlock}, nil),
+			Password: "terraform",
+		},
+		{
+			Name:     "Encrypted Private Key with the wrong password",
+			Data:     bytes.Join([][]byte{certificateBlock, encryptedBlock}, nil),
+			Password: "not-the-password",
+			Error:    true,
+		},
+		{
+			Name:  "Encrypted Private Key without a password",
+			Data:  bytes.Join([][]byte{certificateBlock, encryptedBlock}, nil),
+			Error: true,
+		},
+		{
+			Name:  "No Private Key",
+			Data:  certificateBlock,
+			Error: true,
+		},
+		{
+			Name:  "No Certificate",
+			Data:  pkcs1Block,
+			Error: true,
+		},
+		{
+			Name:  "Not a Certificate",
+			Data:  []byte("not-a-certificate"),
+			Error: true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actualCertificate, actualPrivateKey, err := decodeClientCertificate(v.Data, v.Password)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !actualCertificate.Equal(certificate) {
+			t.Fatalf("Expected the decoded Certificate to match the generated Certificate")
+		}
+
+		if actualPrivateKey.N.Cmp(privateKey.N) != 0 {
+			t.Fatalf("Expected the decoded Private Key to match the generated Private Key")
+		}
+	}
+}
+
+func TestDecodeClientCertificatePKCS12(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/client_certificate_test.pfx")
+	if err != nil {
+		t.Fatalf("Error reading PKCS#12 bundle: %+v", err)
+	}
+
+	certificate, privateKey, err := decodeClientCertificate(data, "terraform")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if certificate.Subject.CommonName != "terraform-client-certificate-test" {
+		t.Fatalf("Expected the Common Name to be %q but got %q", "terraform-client-certificate-test", certificate.Subject.CommonName)
+	}
+
+	if privateKey.N.Cmp(certificate.PublicKey.(*rsa.PublicKey).N) != 0 {
+		t.Fatalf("Expected the Private Key to match the Certificate")
+	}
+
+	if _, _, err := decodeClientCertificate(data, "not-the-password"); err == nil {
+		t.Fatalf("Expected an error when using the wrong password but didn't get one")
+	}
+}
+
+func TestBuildAuthenticationConfigClientCertificate(t *testing.T) {
+	privateKey, certificate := generateTestClientCertificate(t)
+
+	dir, err := ioutil.TempDir("", "tf-client-certificate")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	pemPath := filepath.Join(dir, "client.pem")
+	data := append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})...,
+	)
+	if err := ioutil.WriteFile(pemPath, data, 0600); err != nil {
+		t.Fatalf("Error writing Client Certificate: %+v", err)
+	}
+
+	testCases := []struct {
+		Name                  string
+		Path                  string
+		Password              string
+		ClientID              string
+		ExpectedTokenProvider bool
+		Error                 bool
+	}{
+		{
+			Name:                  "PEM",
+			Path:                  pemPath,
+			ClientID:              "11111111-1111-1111-1111-111111111111",
+			ExpectedTokenProvider: true,
+		},
+		{
+			Name:     "PEM without a Client ID",
+			Path:     pemPath,
+			ClientID: "",
+			Error:    true,
+		},
+		{
+			Name:     "PEM which doesn't exist",
+			Path:     filepath.Join(dir, "missing.pem"),
+			ClientID: "11111111-1111-1111-1111-111111111111",
+			Error:    true,
+		},
+		{
+			Name:                  "PKCS#12",
+			Path:                  "testdata/client_certificate_test.pfx",
+			Password:              "terraform",
+			ClientID:              "11111111-1111-1111-1111-111111111111",
+			ExpectedTokenProvider: false,
+		},
+		{
+			Name:     "PKCS#12 which doesn't exist",
+			Path:     filepath.Join(dir, "missing.pfx"),
+			ClientID: "11111111-1111-1111-1111-111111111111",
+			Error:    true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		builder := authentication.Builder{
+			SubscriptionID:         "00000000-0000-0000-0000-000000000000",
+			ClientID:               v.ClientID,
+			ClientCertPath:         v.Path,
+			ClientCertPassword:     v.Password,
+			TenantID:               "22222222-2222-2222-2222-222222222222",
+			Environment:            "public",
+			SupportsClientCertAuth: true,
+		}
+
+		config, tokenProvider, err := buildAuthenticationConfig(builder)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !config.AuthenticatedAsAServicePrincipal {
+			t.Fatalf("Expected to be authenticated as a Service Principal")
+		}
+
+		if (tokenProvider != nil) != v.ExpectedTokenProvider {
+			t.Fatalf("Expected a Token Provider to be returned: %t", v.ExpectedTokenProvider)
+		}
+	}
+}
+
+func TestArmClientUsesClientCertificate(t *testing.T) {
+	privateKey, certificate := generateTestClientCertificate(t)
+
+	file, err := ioutil.TempFile("", "tf-client-certificate")
+	if err != nil {
+		t.Fatalf("Error creating temporary file: %+v", err)
+	}
+	defer os.Remove(file.Name())
+
+	pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})
+	pem.Encode(file, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	file.Close()
+
+	builder := authentication.Builder{
+		SubscriptionID:         "00000000-0000-0000-0000-000000000000",
+		ClientID:               "11111111-1111-1111-1111-111111111111",
+		ClientCertPath:         file.Name(),
+		TenantID:               "22222222-2222-2222-2222-222222222222",
+		Environment:            "public",
+		SupportsClientCertAuth: true,
+	}
+	config, tokenProvider, err := buildAuthenticationConfig(builder)
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	// no token is acquired until the first request is sent, so this doesn't require access to Azure
+	client, err := getArmClient(config, armClientOptions{
+		skipProviderRegistration: true,
+		retryPolicy:              azure.DefaultRetryPolicy(),
+		tokenProvider:            tokenProvider,
+	})
+	if err != nil {
+		t.Fatalf("Error building ARM Client: %+v", err)
+	}
+
+	if client.resourceManagerAuth == nil {
+		t.Fatalf("Expected an Authorizer for Resource Manager")
+	}
+
+	if !client.usingServicePrincipal {
+		t.Fatalf("Expected to be authenticated as a Service Principal")
+	}
+}
+
+// generateTestClientCertificate generates a throwaway self-signed Client Certificate and Private Key
+func generateTestClientCertificate(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating Private Key: %+v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName: "terraform-client-certificate-test",
+		},
+		NotBefore:   time.Now().Add(-time.Hour),
+		NotAfter:    time.Now().Add(time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	data, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("Error creating Certificate: %+v", err)
+	}
+
+	certificate, err := x509.ParseCertificate(data)
+	if err != nil {
+		t.Fatalf("Error parsing Certificate: %+v", err)
+	}
+
+	return privateKey, certificate
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
@@ -67,7 +68,6 @@ import (
 	"github.com/Azure/go-autorest/autorest/adal"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
-	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -82,93 +82,252 @@ type ArmClient struct {
 	subscriptionId           string
 	usingServicePrincipal    bool
 	environment              az.Environment
+	dataLakeStoreDNSSuffix   string
 	skipProviderRegistration bool
+	retryPolicy              azure.RetryPolicy
+	defaultTags              map[string]string
+
+	// requestLimiter limits the number of concurrent requests made by all of the clients (including the
+	// data plane clients) for this Provider instance, to avoid exhausting the Subscription's rate limits
+	requestLimiter *azure.RequestLimiter
+
+	// resourceProviderRegistrar registers Resource Providers on demand (and, when `resource_provider_registrations`
+	// is set to `used`, the first time each is used) - and is nil when `skip_provider_registration` is set
+	resourceProviderRegistrar *resourceProviderRegistrar
+
+	// requireResourcesToBeImported determines whether resources which already exist when being created
+	// should return an error (requiring they're imported) rather than being adopted into the State
+	requireResourcesToBeImported bool
 
 	StopContext context.Context
 
-	cosmosDBClient documentdb.DatabaseAccountsClient
+	// the clients for each service are only built (and authorized) the first time they're used, rather than
+	// when the Provider is configured - such that tokens are only acquired for the API's which are used
+	resourceManagerEndpoint string
+	resourceManagerAuth     autorest.Authorizer
+	graphEndpoint           string
+	graphAuth               autorest.Authorizer
+	keyVaultAuth            autorest.Authorizer
+	storageAuth             autorest.Authorizer
+	storageUseAzureAD       bool
 
-	automationAccountClient               automation.AccountClient
-	automationAgentRegistrationInfoClient automation.AgentRegistrationInformationClient
-	automationCredentialClient            automation.CredentialClient
-	automationDscConfigurationClient      automation.DscConfigurationClient
-	automationDscNodeConfigurationClient  automation.DscNodeConfigurationClient
-	automationModuleClient                automation.ModuleClient
-	automationRunbookClient               automation.RunbookClient
-	automationRunbookDraftClient          automation.RunbookDraftClient
-	automationScheduleClient              automation.ScheduleClient
+	apiManagementOnce    sync.Once
+	apiManagementClients *apiManagementClients
 
-	dnsClient   dns.RecordSetsClient
-	zonesClient dns.ZonesClient
+	appInsightsOnce    sync.Once
+	appInsightsClients *appInsightsClients
 
-	containerRegistryClient             containerregistry.RegistriesClient
-	containerRegistryReplicationsClient containerregistry.ReplicationsClient
-	containerServicesClient             containerservice.ContainerServicesClient
-	kubernetesClustersClient            containerservice.ManagedClustersClient
-	containerGroupsClient               containerinstance.ContainerGroupsClient
+	automationOnce    sync.Once
+	automationClients *automationClients
 
-	eventGridTopicsClient       eventgrid.TopicsClient
-	eventHubClient              eventhub.EventHubsClient
-	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
-	eventHubNamespacesClient    eventhub.NamespacesClient
+	authorizationOnce    sync.Once
+	authorizationClients *authorizationClients
 
-	solutionsClient operationsmanagement.SolutionsClient
+	graphOnce    sync.Once
+	graphClients *graphClients
 
-	redisClient               redis.Client
-	redisFirewallClient       redis.FirewallRulesClient
-	redisPatchSchedulesClient redis.PatchSchedulesClient
+	cdnOnce    sync.Once
+	cdnClients *cdnClients
+
+	cognitiveOnce    sync.Once
+	cognitiveClients *cognitiveClients
+
+	cosmosDBOnce    sync.Once
+	cosmosDBClients *cosmosDBClients
+
+	computeOnce    sync.Once
+	computeClients *computeClients
+
+	containerInstanceOnce    sync.Once
+	containerInstanceClients *containerInstanceClients
+
+	containerRegistryOnce    sync.Once
+	containerRegistryClients *containerRegistryClients
+
+	containerServicesOnce    sync.Once
+	containerServicesClients *containerServicesClients
+
+	databricksOnce    sync.Once
+	databricksClients *databricksClients
+
+	databasesOnce    sync.Once
+	databasesClients *databasesClients
+
+	dataLakeOnce    sync.Once
+	dataLakeClients *dataLakeClients
+
+	devicesOnce    sync.Once
+	devicesClients *devicesClients
+
+	devTestOnce    sync.Once
+	devTestClients *devTestClients
+
+	devSpaceOnce    sync.Once
+	devSpaceClients *devSpaceClients
+
+	dnsOnce    sync.Once
+	dnsClients *dnsClients
 
-	// API Management
+	eventGridOnce    sync.Once
+	eventGridClients *eventGridClients
+
+	eventHubOnce    sync.Once
+	eventHubClients *eventHubClients
+
+	keyVaultOnce    sync.Once
+	keyVaultClients *keyVaultClients
+
+	logicOnce    sync.Once
+	logicClients *logicClients
+
+	monitorOnce    sync.Once
+	monitorClients *monitorClients
+
+	networkOnce    sync.Once
+	networkClients *networkClients
+
+	notificationHubsOnce    sync.Once
+	notificationHubsClients *notificationHubsClients
+
+	operationalInsightsOnce    sync.Once
+	operationalInsightsClients *operationalInsightsClients
+
+	recoveryServicesOnce    sync.Once
+	recoveryServicesClients *recoveryServicesClients
+
+	redisOnce    sync.Once
+	redisClients *redisClients
+
+	relayOnce    sync.Once
+	relayClients *relayClients
+
+	resourcesOnce    sync.Once
+	resourcesClients *resourcesClients
+
+	schedulerOnce    sync.Once
+	schedulerClients *schedulerClients
+
+	searchOnce    sync.Once
+	searchClients *searchClients
+
+	securityCenterOnce    sync.Once
+	securityCenterClients *securityCenterClients
+
+	serviceBusOnce    sync.Once
+	serviceBusClients *serviceBusClients
+
+	serviceFabricOnce    sync.Once
+	serviceFabricClients *serviceFabricClients
+
+	storageOnce    sync.Once
+	storageClients *storageClients
+
+	trafficManagerOnce    sync.Once
+	trafficManagerClients *trafficManagerClients
+
+	webOnce    sync.Once
+	webClients *webClients
+
+	policyOnce    sync.Once
+	policyClients *policyClients
+
+	managementGroupsOnce    sync.Once
+	managementGroupsClients *managementGroupsClients
+}
+
+// apiManagementClients contains the clients used to manage API Management resources
+type apiManagementClients struct {
 	apiManagementServiceClient apimanagement.ServiceClient
+}
 
-	// Application Insights
+// appInsightsClients contains the clients used to manage Application Insights resources
+type appInsightsClients struct {
 	appInsightsClient appinsights.ComponentsClient
+}
+
+// automationClients contains the clients used to manage Automation resources
+type automationClients struct {
+	automationAccountClient               automation.AccountClient
+	automationAgentRegistrationInfoClient automation.AgentRegistrationInformationClient
+	automationCredentialClient            automation.CredentialClient
+	automationDscConfigurationClient      automation.DscConfigurationClient
+	automationDscNodeConfigurationClient  automation.DscNodeConfigurationClient
+	automationModuleClient                automation.ModuleClient
+	automationRunbookClient               automation.RunbookClient
+	automationScheduleClient              automation.ScheduleClient
+	automationRunbookDraftClient          automation.RunbookDraftClient
+}
 
-	// Authentication
-	roleAssignmentsClient   authorization.RoleAssignmentsClient
-	roleDefinitionsClient   authorization.RoleDefinitionsClient
+// authorizationClients contains the clients used to manage Authorization resources
+type authorizationClients struct {
+	roleAssignmentsClient authorization.RoleAssignmentsClient
+	roleDefinitionsClient authorization.RoleDefinitionsClient
+}
+
+// graphClients contains the clients used to manage Azure Active Directory (via the Graph API) resources
+type graphClients struct {
 	applicationsClient      graphrbac.ApplicationsClient
 	servicePrincipalsClient graphrbac.ServicePrincipalsClient
+}
 
-	// Autoscale Settings
-	autoscaleSettingsClient insights.AutoscaleSettingsClient
-
-	// CDN
+// cdnClients contains the clients used to manage CDN resources
+type cdnClients struct {
 	cdnCustomDomainsClient cdn.CustomDomainsClient
 	cdnEndpointsClient     cdn.EndpointsClient
 	cdnProfilesClient      cdn.ProfilesClient
+}
 
-	// Cognitive Services
+// cognitiveClients contains the clients used to manage Cognitive Services resources
+type cognitiveClients struct {
 	cognitiveAccountsClient cognitiveservices.AccountsClient
+}
+
+// cosmosDBClients contains the clients used to manage CosmosDB resources
+type cosmosDBClients struct {
+	cosmosDBClient documentdb.DatabaseAccountsClient
+}
 
-	// Compute
+// computeClients contains the clients used to manage Compute resources
+type computeClients struct {
 	availSetClient             compute.AvailabilitySetsClient
 	diskClient                 compute.DisksClient
 	imageClient                compute.ImagesClient
-	galleriesClient            compute.GalleriesClient
-	galleryImagesClient        compute.GalleryImagesClient
-	galleryImageVersionsClient compute.GalleryImageVersionsClient
 	snapshotsClient            compute.SnapshotsClient
 	usageOpsClient             compute.UsageClient
 	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
 	vmExtensionClient          compute.VirtualMachineExtensionsClient
-	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
 	vmImageClient              compute.VirtualMachineImagesClient
+	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
 	vmClient                   compute.VirtualMachinesClient
+	galleriesClient            compute.GalleriesClient
+	galleryImagesClient        compute.GalleryImagesClient
+	galleryImageVersionsClient compute.GalleryImageVersionsClient
+}
 
-	// Devices
-	iothubResourceClient devices.IotHubResourceClient
+// containerInstanceClients contains the clients used to manage Container Instances resources
+type containerInstanceClients struct {
+	containerGroupsClient containerinstance.ContainerGroupsClient
+}
 
-	// DevTestLabs
-	devTestLabsClient            dtl.LabsClient
-	devTestPoliciesClient        dtl.PoliciesClient
-	devTestVirtualMachinesClient dtl.VirtualMachinesClient
-	devTestVirtualNetworksClient dtl.VirtualNetworksClient
+// containerRegistryClients contains the clients used to manage Container Registry resources
+type containerRegistryClients struct {
+	containerRegistryClient             containerregistry.RegistriesClient
+	containerRegistryReplicationsClient containerregistry.ReplicationsClient
+}
 
-	// DevSpace
-	devSpaceControllerClient devspaces.ControllersClient
+// containerServicesClients contains the clients used to manage Container Services resources
+type containerServicesClients struct {
+	containerServicesClient  containerservice.ContainerServicesClient
+	kubernetesClustersClient containerservice.ManagedClustersClient
+}
+
+// databricksClients contains the clients used to manage Databricks resources
+type databricksClients struct {
+	databricksWorkspacesClient databricks.WorkspacesClient
+}
 
-	// Databases
+// databasesClients contains the clients used to manage Databases resources
+type databasesClients struct {
 	mysqlConfigurationsClient                mysql.ConfigurationsClient
 	mysqlDatabasesClient                     mysql.DatabasesClient
 	mysqlFirewallRulesClient                 mysql.FirewallRulesClient
@@ -181,54 +340,85 @@ type ArmClient struct {
 	postgresqlVirtualNetworkRulesClient      postgresql.VirtualNetworkRulesClient
 	sqlDatabasesClient                       sql.DatabasesClient
 	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
+	sqlFirewallRulesClient                   sql.FirewallRulesClient
 	sqlElasticPoolsClient                    sql.ElasticPoolsClient
 	// Client for the new 2017-10-01-preview SQL API which implements vCore, DTU, and Azure data standards
 	msSqlElasticPoolsClient              MsSql.ElasticPoolsClient
-	sqlFirewallRulesClient               sql.FirewallRulesClient
 	sqlServersClient                     sql.ServersClient
 	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
 	sqlVirtualNetworkRulesClient         sql.VirtualNetworkRulesClient
+}
 
-	// Data Lake Store
-	dataLakeStoreAccountClient       storeAccount.AccountsClient
-	dataLakeStoreFirewallRulesClient storeAccount.FirewallRulesClient
-	dataLakeStoreFilesClient         filesystem.Client
-
-	// Data Lake Analytics
+// dataLakeClients contains the clients used to manage Data Lake resources
+type dataLakeClients struct {
+	dataLakeStoreAccountClient           storeAccount.AccountsClient
+	dataLakeStoreFirewallRulesClient     storeAccount.FirewallRulesClient
 	dataLakeAnalyticsAccountClient       analyticsAccount.AccountsClient
+	dataLakeStoreFilesClient             filesystem.Client
 	dataLakeAnalyticsFirewallRulesClient analyticsAccount.FirewallRulesClient
+}
 
-	// Databricks
-	databricksWorkspacesClient databricks.WorkspacesClient
+// devicesClients contains the clients used to manage Devices resources
+type devicesClients struct {
+	iothubResourceClient devices.IotHubResourceClient
+}
+
+// devTestClients contains the clients used to manage DevTestLabs resources
+type devTestClients struct {
+	devTestLabsClient            dtl.LabsClient
+	devTestPoliciesClient        dtl.PoliciesClient
+	devTestVirtualMachinesClient dtl.VirtualMachinesClient
+	devTestVirtualNetworksClient dtl.VirtualNetworksClient
+}
+
+// devSpaceClients contains the clients used to manage DevSpace resources
+type devSpaceClients struct {
+	devSpaceControllerClient devspaces.ControllersClient
+}
+
+// dnsClients contains the clients used to manage DNS resources
+type dnsClients struct {
+	dnsClient   dns.RecordSetsClient
+	zonesClient dns.ZonesClient
+}
+
+// eventGridClients contains the clients used to manage EventGrid resources
+type eventGridClients struct {
+	eventGridTopicsClient eventgrid.TopicsClient
+}
+
+// eventHubClients contains the clients used to manage EventHub resources
+type eventHubClients struct {
+	eventHubClient              eventhub.EventHubsClient
+	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
+	eventHubNamespacesClient    eventhub.NamespacesClient
+}
 
-	// KeyVault
+// keyVaultClients contains the clients used to manage KeyVault resources
+type keyVaultClients struct {
 	keyVaultClient           keyvault.VaultsClient
 	keyVaultManagementClient keyVault.BaseClient
+}
 
-	// Log Analytics
-	linkedServicesClient operationalinsights.LinkedServicesClient
-	workspacesClient     operationalinsights.WorkspacesClient
-
-	// Logic
+// logicClients contains the clients used to manage Logic resources
+type logicClients struct {
 	logicWorkflowsClient logic.WorkflowsClient
+}
 
-	// Management Groups
-	managementGroupsClient             managementgroups.Client
-	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
-
-	// Monitor
+// monitorClients contains the clients used to manage Monitor resources
+type monitorClients struct {
 	monitorActionGroupsClient               insights.ActionGroupsClient
 	monitorActivityLogAlertsClient          insights.ActivityLogAlertsClient
 	monitorAlertRulesClient                 insights.AlertRulesClient
-	monitorDiagnosticSettingsClient         insights.DiagnosticSettingsClient
-	monitorDiagnosticSettingsCategoryClient insights.DiagnosticSettingsCategoryClient
 	monitorLogProfilesClient                insights.LogProfilesClient
 	monitorMetricAlertsClient               insights.MetricAlertsClient
+	autoscaleSettingsClient                 insights.AutoscaleSettingsClient
+	monitorDiagnosticSettingsClient         insights.DiagnosticSettingsClient
+	monitorDiagnosticSettingsCategoryClient insights.DiagnosticSettingsCategoryClient
+}
 
-	// MSI
-	userAssignedIdentitiesClient msi.UserAssignedIdentitiesClient
-
-	// Networking
+// networkClients contains the clients used to manage Networking resources
+type networkClients struct {
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
 	azureFirewallsClient            network.AzureFirewallsClient
@@ -238,108 +428,199 @@ type ArmClient struct {
 	ifaceClient                     network.InterfacesClient
 	loadBalancerClient              network.LoadBalancersClient
 	localNetConnClient              network.LocalNetworkGatewaysClient
+	vnetGatewayClient               network.VirtualNetworkGatewaysClient
+	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
+	vnetClient                      network.VirtualNetworksClient
 	packetCapturesClient            network.PacketCapturesClient
+	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
 	publicIPClient                  network.PublicIPAddressesClient
 	routesClient                    network.RoutesClient
 	routeTablesClient               network.RouteTablesClient
 	secGroupClient                  network.SecurityGroupsClient
 	secRuleClient                   network.SecurityRulesClient
 	subnetClient                    network.SubnetsClient
-	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
-	vnetGatewayClient               network.VirtualNetworkGatewaysClient
-	vnetClient                      network.VirtualNetworksClient
-	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	userAssignedIdentitiesClient    msi.UserAssignedIdentitiesClient
 	watcherClient                   network.WatchersClient
+}
 
-	// Notification Hubs
-	notificationHubsClient       notificationhubs.Client
+// notificationHubsClients contains the clients used to manage Notification Hubs resources
+type notificationHubsClients struct {
 	notificationNamespacesClient notificationhubs.NamespacesClient
+	notificationHubsClient       notificationhubs.Client
+}
 
-	// Recovery Services
+// operationalInsightsClients contains the clients used to manage Log Analytics resources
+type operationalInsightsClients struct {
+	workspacesClient     operationalinsights.WorkspacesClient
+	solutionsClient      operationsmanagement.SolutionsClient
+	linkedServicesClient operationalinsights.LinkedServicesClient
+}
+
+// recoveryServicesClients contains the clients used to manage Recovery Services resources
+type recoveryServicesClients struct {
 	recoveryServicesVaultsClient             recoveryservices.VaultsClient
 	recoveryServicesProtectedItemsClient     backup.ProtectedItemsClient
 	recoveryServicesProtectionPoliciesClient backup.ProtectionPoliciesClient
+}
+
+// redisClients contains the clients used to manage Redis resources
+type redisClients struct {
+	redisClient               redis.Client
+	redisFirewallClient       redis.FirewallRulesClient
+	redisPatchSchedulesClient redis.PatchSchedulesClient
+}
 
-	// Relay
+// relayClients contains the clients used to manage Relay resources
+type relayClients struct {
 	relayNamespacesClient relay.NamespacesClient
+}
 
-	// Resources
+// resourcesClients contains the clients used to manage Resources resources
+type resourcesClients struct {
 	managementLocksClient locks.ManagementLocksClient
 	deploymentsClient     resources.DeploymentsClient
-	providersClient       resources.ProvidersClient
 	resourcesClient       resources.Client
 	resourceGroupsClient  resources.GroupsClient
+	providersClient       resources.ProvidersClient
 	subscriptionsClient   subscriptions.Client
 
-	// Scheduler
-	schedulerJobCollectionsClient scheduler.JobCollectionsClient //nolint: megacheck
+	// subscriptionDeploymentsClient manages Template Deployments at the Subscription scope, which the Resources SDK doesn't support
+	subscriptionDeploymentsClient subscriptionDeploymentsClient
+}
 
-	schedulerJobsClient scheduler.JobsClient //nolint: megacheck
+// schedulerClients contains the clients used to manage Scheduler resources
+type schedulerClients struct {
+	schedulerJobCollectionsClient scheduler.JobCollectionsClient //nolint: megacheck
+	schedulerJobsClient           scheduler.JobsClient           //nolint: megacheck
+}
 
-	// Search
+// searchClients contains the clients used to manage Search resources
+type searchClients struct {
 	searchServicesClient  search.ServicesClient
 	searchAdminKeysClient search.AdminKeysClient
+}
 
-	// Security Centre
+// securityCenterClients contains the clients used to manage Security Center resources
+type securityCenterClients struct {
 	securityCenterPricingClient   security.PricingsClient
 	securityCenterContactsClient  security.ContactsClient
 	securityCenterWorkspaceClient security.WorkspaceSettingsClient
+}
 
-	// ServiceBus
+// serviceBusClients contains the clients used to manage ServiceBus resources
+type serviceBusClients struct {
 	serviceBusQueuesClient            servicebus.QueuesClient
 	serviceBusNamespacesClient        servicebus.NamespacesClient
 	serviceBusTopicsClient            servicebus.TopicsClient
 	serviceBusSubscriptionsClient     servicebus.SubscriptionsClient
 	serviceBusSubscriptionRulesClient servicebus.RulesClient
+}
 
-	// Service Fabric
+// serviceFabricClients contains the clients used to manage Service Fabric resources
+type serviceFabricClients struct {
 	serviceFabricClustersClient servicefabric.ClustersClient
+}
 
-	// Storage
+// storageClients contains the clients used to manage Storage resources
+type storageClients struct {
 	storageServiceClient storage.AccountsClient
 	storageUsageClient   storage.UsageClient
 
-	// Traffic Manager
+	// storageAccountServicesClient manages the Blob Service Properties, which the Storage SDK doesn't support
+	storageAccountServicesClient storageAccountServicesClient
+
+	// storageManagementPoliciesClient manages the Lifecycle Management Policies, which the Storage SDK doesn't support
+	storageManagementPoliciesClient storageManagementPoliciesClient
+}
+
+// trafficManagerClients contains the clients used to manage Traffic Manager resources
+type trafficManagerClients struct {
+	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
 	trafficManagerProfilesClient               trafficmanager.ProfilesClient
-	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
+}
 
-	// Web
+// webClients contains the clients used to manage Web resources
+type webClients struct {
 	appServicePlansClient web.AppServicePlansClient
 	appServicesClient     web.AppsClient
+}
 
-	// Policy
+// policyClients contains the clients used to manage Policy resources
+type policyClients struct {
 	policyAssignmentsClient policy.AssignmentsClient
 	policyDefinitionsClient policy.DefinitionsClient
 }
 
-var (
-	msClientRequestIDOnce sync.Once
-	msClientRequestID     string
-)
+// managementGroupsClients contains the clients used to manage Management Groups resources
+type managementGroupsClients struct {
+	managementGroupsClient             managementgroups.Client
+	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
+}
 
-// clientRequestID generates a UUID to pass through `x-ms-client-request-id` header.
-func clientRequestID() string {
-	msClientRequestIDOnce.Do(func() {
-		var err error
-		msClientRequestID, err = uuid.GenerateUUID()
+func init() {
+	// throttled & transient failures are retried by the Sender (see `azure.BuildSender`), which honours the
+	// `max_retries` - however the SDK clients also retry these Status Codes within `DoRetryWithRegistration` (and
+	// retry throttled requests indefinitely), as such autorest's retries are disabled to avoid retrying twice
+	autorest.StatusCodesForRetry = []int{}
+}
 
-		if err != nil {
-			log.Printf("[WARN] Fail to generate uuid for msClientRequestID: %+v", err)
-		}
-	})
+func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
+	setUserAgent(client)
+	client.Authorizer = auth
+	client.Sender = c.buildSender()
+
+	// Resource Providers are either not registered (when `skip_provider_registration` is set) or registered by the
+	// registrar, which honours `excluded_resource_providers` - so autorest's built-in registration is never used
+	client.SkipResourceProviderRegistration = true
 
-	log.Printf("[DEBUG] AzureRM Client Request Id: %s", msClientRequestID)
-	return msClientRequestID
+	if c.resourceProviderRegistrar != nil {
+		client.Sender = autorest.DecorateSender(client.Sender, c.resourceProviderRegistrar.withRegistration())
+	}
+
+	// the SDK's `DoRetryWithRegistration` only sends the request when at least one attempt is allowed - since
+	// requests are retried by the Sender (and Resource Providers registered by the registrar) one attempt is used
+	client.RetryAttempts = 1
+
+	// the deadline for long-running operations is taken from the context passed in by each
+	// resource (which is bound to that resource's Timeouts) - as such this is only an upper bound
+	client.PollingDuration = 24 * time.Hour
 }
 
-func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
+// configureStorageClient configures a client for the Storage data plane - unlike configureClient these requests
+// aren't sent to Resource Manager, so there are no Resource Providers to register
+func (c *ArmClient) configureStorageClient(client *autorest.Client, auth autorest.Authorizer) {
 	setUserAgent(client)
 	client.Authorizer = auth
-	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
-	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
+	client.Sender = c.buildSender()
+}
+
+// buildSender returns a Sender which shares this Provider instance's retry policy and concurrency limits
+func (c *ArmClient) buildSender() autorest.Sender {
+	return azure.BuildSender(c.retryPolicy, c.requestLimiter)
+}
+
+// storageSender sends the requests made by the Storage data plane clients using a Sender built for the
+// ArmClient, such that these are retried, logged and limited in the same way as all other requests
+type storageSender struct {
+	sender autorest.Sender
+
+	// authorizer (when set) replaces the Shared Key signature on each request with a bearer token
+	authorizer autorest.Authorizer
+}
+
+func (s storageSender) Send(_ *mainStorage.Client, req *http.Request) (*http.Response, error) {
+	if s.authorizer != nil {
+		req.Header.Del("Authorization")
+
+		var err error
+		req, err = autorest.Prepare(req, s.authorizer.WithAuthorization())
+		if err != nil {
+			return nil, fmt.Errorf("Error authorizing the request to the Storage Account: %+v", err)
+		}
+	}
+
+	return s.sender.Do(req)
 }
 
 func setUserAgent(client *autorest.Client) {
@@ -358,10 +639,34 @@ func setUserAgent(client *autorest.Client) {
 	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
 }
 
+// authorizationTokenProvider acquires the tokens used to authenticate against Resource Manager, the Graph API and Key Vault
+type authorizationTokenProvider interface {
+	GetAuthorizationToken(oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error)
+}
+
+// armClientOptions are the options used to configure the ArmClient which aren't part of the authentication.Config
+type armClientOptions struct {
+	skipProviderRegistration bool
+	retryPolicy              azure.RetryPolicy
+	requestLimiter           *azure.RequestLimiter
+
+	// tokenProvider overrides the authentication.Config when acquiring tokens, for authentication
+	// methods which aren't supported by the authentication.Builder (e.g. PEM-encoded Client Certificates)
+	tokenProvider authorizationTokenProvider
+
+	// customEnvironment overrides the Environment named in the authentication.Config, for Clouds which
+	// aren't built into go-autorest (e.g. Azure Stack) - loaded from a Metadata Host or an Environment File
+	customEnvironment *azure.Environment
+
+	// storageUseAzureAD authenticates against the Storage data plane using Azure Active Directory rather than
+	// the Storage Account's Access Key
+	storageUseAzureAD bool
+}
+
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool) (*ArmClient, error) {
-	env, err := authentication.DetermineEnvironment(c.Environment)
+func getArmClient(c *authentication.Config, options armClientOptions) (*ArmClient, error) {
+	env, dataLakeStoreDNSSuffix, err := determineEnvironment(c, options.customEnvironment)
 	if err != nil {
 		return nil, err
 	}
@@ -372,8 +677,17 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool) (*Arm
 		tenantId:                 c.TenantID,
 		subscriptionId:           c.SubscriptionID,
 		environment:              *env,
+		dataLakeStoreDNSSuffix:   dataLakeStoreDNSSuffix,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
-		skipProviderRegistration: skipProviderRegistration,
+		skipProviderRegistration: options.skipProviderRegistration,
+		retryPolicy:              options.retryPolicy,
+		requestLimiter:           options.requestLimiter,
+		storageUseAzureAD:        options.storageUseAzureAD,
+	}
+
+	var tokenProvider authorizationTokenProvider = c
+	if options.tokenProvider != nil {
+		tokenProvider = options.tokenProvider
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -387,23 +701,38 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool) (*Arm
 	}
 
 	// Resource Manager endpoints
-	endpoint := env.ResourceManagerEndpoint
-	auth, err := c.GetAuthorizationToken(oauthConfig, env.TokenAudience)
+	client.resourceManagerEndpoint = env.ResourceManagerEndpoint
+	client.resourceManagerAuth, err = tokenProvider.GetAuthorizationToken(oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
-	// Graph Endpoints
-	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := c.GetAuthorizationToken(oauthConfig, graphEndpoint)
-	if err != nil {
-		return nil, err
-	}
+	// Graph Endpoints - the token is only acquired when an AzureAD resource is used, since not every
+	// principal which has access to Resource Manager has access to the Graph API
+	client.graphEndpoint = env.GraphEndpoint
+	client.graphAuth = azure.NewLazyAuthorizer(func() (autorest.Authorizer, error) {
+		graphAuth, err := tokenProvider.GetAuthorizationToken(oauthConfig, env.GraphEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining a token for the Graph API: %+v", err)
+		}
+
+		return graphAuth, nil
+	})
+
+	// Storage Endpoints - the token is only acquired when the Storage data plane is used, since this is opt-in
+	client.storageAuth = azure.NewLazyAuthorizer(func() (autorest.Authorizer, error) {
+		storageAuth, err := tokenProvider.GetAuthorizationToken(oauthConfig, storageResourceIdentifier)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining a token for the Storage data plane: %+v", err)
+		}
 
-	// Key Vault Endpoints
-	sender := azure.BuildSender()
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
-		keyVaultSpt, err := c.GetAuthorizationToken(oauthConfig, resource)
+		return storageAuth, nil
+	})
+
+	// Key Vault Endpoints - the token is acquired for the resource returned in the challenge on first use
+	sender := client.buildSender()
+	client.keyVaultAuth = autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		keyVaultSpt, err := tokenProvider.GetAuthorizationToken(oauthConfig, resource)
 		if err != nil {
 			return nil, err
 		}
@@ -411,737 +740,1190 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool) (*Arm
 		return keyVaultSpt, nil
 	})
 
-	client.registerApiManagementServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth)
-	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
-	client.registerDeviceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerSecurityCenterClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
-
 	return &client, nil
 }
 
-func (c *ArmClient) registerApiManagementServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+// determineEnvironment returns the Environment (and the Data Lake Store DNS Suffix) to use - which is either the
+// custom Environment or one built into go-autorest, in which case the default Data Lake Store DNS Suffix is used
+func determineEnvironment(c *authentication.Config, customEnvironment *azure.Environment) (*az.Environment, string, error) {
+	if customEnvironment != nil {
+		log.Printf("[DEBUG] Using the custom Environment %q", customEnvironment.Name)
+		return &customEnvironment.Environment, customEnvironment.DataLakeStoreDNSSuffix, nil
+	}
+
+	env, err := authentication.DetermineEnvironment(c.Environment)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return env, "", nil
+}
+
+func (c *ArmClient) apiManagement() *apiManagementClients {
+	c.apiManagementOnce.Do(func() {
+		c.apiManagementClients = c.registerApiManagementServiceClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.apiManagementClients
+}
+
+func (c *ArmClient) registerApiManagementServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) *apiManagementClients {
+	clients := apiManagementClients{}
+
 	ams := apimanagement.NewServiceClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ams.Client, auth)
-	c.apiManagementServiceClient = ams
+	clients.apiManagementServiceClient = ams
+
+	return &clients
 }
 
-func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) appInsights() *appInsightsClients {
+	c.appInsightsOnce.Do(func() {
+		c.appInsightsClients = c.registerAppInsightsClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.appInsightsClients
+}
+
+func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) *appInsightsClients {
+	clients := appInsightsClients{}
+
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ai.Client, auth)
-	c.appInsightsClient = ai
+	clients.appInsightsClient = ai
+
+	return &clients
 }
 
-func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) automation() *automationClients {
+	c.automationOnce.Do(func() {
+		c.automationClients = c.registerAutomationClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.automationClients
+}
+
+func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer) *automationClients {
+	clients := automationClients{}
+
 	accountClient := automation.NewAccountClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&accountClient.Client, auth)
-	c.automationAccountClient = accountClient
+	clients.automationAccountClient = accountClient
 
 	agentRegistrationInfoClient := automation.NewAgentRegistrationInformationClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&agentRegistrationInfoClient.Client, auth)
-	c.automationAgentRegistrationInfoClient = agentRegistrationInfoClient
+	clients.automationAgentRegistrationInfoClient = agentRegistrationInfoClient
 
 	credentialClient := automation.NewCredentialClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&credentialClient.Client, auth)
-	c.automationCredentialClient = credentialClient
+	clients.automationCredentialClient = credentialClient
 
 	dscConfigurationClient := automation.NewDscConfigurationClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dscConfigurationClient.Client, auth)
-	c.automationDscConfigurationClient = dscConfigurationClient
+	clients.automationDscConfigurationClient = dscConfigurationClient
 
 	dscNodeConfigurationClient := automation.NewDscNodeConfigurationClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dscNodeConfigurationClient.Client, auth)
-	c.automationDscNodeConfigurationClient = dscNodeConfigurationClient
+	clients.automationDscNodeConfigurationClient = dscNodeConfigurationClient
 
 	moduleClient := automation.NewModuleClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&moduleClient.Client, auth)
-	c.automationModuleClient = moduleClient
+	clients.automationModuleClient = moduleClient
 
 	runbookClient := automation.NewRunbookClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&runbookClient.Client, auth)
-	c.automationRunbookClient = runbookClient
+	clients.automationRunbookClient = runbookClient
 
 	scheduleClient := automation.NewScheduleClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&scheduleClient.Client, auth)
-	c.automationScheduleClient = scheduleClient
+	clients.automationScheduleClient = scheduleClient
 
 	runbookDraftClient := automation.NewRunbookDraftClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&runbookDraftClient.Client, auth)
-	c.automationRunbookDraftClient = runbookDraftClient
+	clients.automationRunbookDraftClient = runbookDraftClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer) {
+func (c *ArmClient) authorization() *authorizationClients {
+	c.authorizationOnce.Do(func() {
+		c.authorizationClients = c.registerAuthorizationClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.authorizationClients
+}
+
+func (c *ArmClient) registerAuthorizationClients(endpoint, subscriptionId string, auth autorest.Authorizer) *authorizationClients {
+	clients := authorizationClients{}
+
 	assignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&assignmentsClient.Client, auth)
-	c.roleAssignmentsClient = assignmentsClient
+	clients.roleAssignmentsClient = assignmentsClient
 
 	definitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&definitionsClient.Client, auth)
-	c.roleDefinitionsClient = definitionsClient
+	clients.roleDefinitionsClient = definitionsClient
+
+	return &clients
+}
+
+func (c *ArmClient) graph() *graphClients {
+	c.graphOnce.Do(func() {
+		c.graphClients = c.registerGraphClients(c.graphEndpoint, c.tenantId, c.graphAuth)
+	})
+	return c.graphClients
+}
+
+func (c *ArmClient) registerGraphClients(graphEndpoint, tenantId string, graphAuth autorest.Authorizer) *graphClients {
+	clients := graphClients{}
 
 	applicationsClient := graphrbac.NewApplicationsClientWithBaseURI(graphEndpoint, tenantId)
 	c.configureClient(&applicationsClient.Client, graphAuth)
-	c.applicationsClient = applicationsClient
+	clients.applicationsClient = applicationsClient
 
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
 	c.configureClient(&servicePrincipalsClient.Client, graphAuth)
-	c.servicePrincipalsClient = servicePrincipalsClient
+	clients.servicePrincipalsClient = servicePrincipalsClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) cdn() *cdnClients {
+	c.cdnOnce.Do(func() {
+		c.cdnClients = c.registerCDNClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.cdnClients
+}
+
+func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer) *cdnClients {
+	clients := cdnClients{}
+
 	customDomainsClient := cdn.NewCustomDomainsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&customDomainsClient.Client, auth)
-	c.cdnCustomDomainsClient = customDomainsClient
+	clients.cdnCustomDomainsClient = customDomainsClient
 
 	endpointsClient := cdn.NewEndpointsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&endpointsClient.Client, auth)
-	c.cdnEndpointsClient = endpointsClient
+	clients.cdnEndpointsClient = endpointsClient
 
 	profilesClient := cdn.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&profilesClient.Client, auth)
-	c.cdnProfilesClient = profilesClient
+	clients.cdnProfilesClient = profilesClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerCognitiveServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) cognitive() *cognitiveClients {
+	c.cognitiveOnce.Do(func() {
+		c.cognitiveClients = c.registerCognitiveServiceClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.cognitiveClients
+}
+
+func (c *ArmClient) registerCognitiveServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) *cognitiveClients {
+	clients := cognitiveClients{}
+
 	accountsClient := cognitiveservices.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&accountsClient.Client, auth)
-	c.cognitiveAccountsClient = accountsClient
+	clients.cognitiveAccountsClient = accountsClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerCosmosDBClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) cosmosDB() *cosmosDBClients {
+	c.cosmosDBOnce.Do(func() {
+		c.cosmosDBClients = c.registerCosmosDBClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.cosmosDBClients
+}
+
+func (c *ArmClient) registerCosmosDBClients(endpoint, subscriptionId string, auth autorest.Authorizer) *cosmosDBClients {
+	clients := cosmosDBClients{}
+
 	cdb := documentdb.NewDatabaseAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cdb.Client, auth)
-	c.cosmosDBClient = cdb
+	clients.cosmosDBClient = cdb
+
+	return &clients
+}
+
+func (c *ArmClient) compute() *computeClients {
+	c.computeOnce.Do(func() {
+		c.computeClients = c.registerComputeClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.computeClients
 }
 
-func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth autorest.Authorizer) *computeClients {
+	clients := computeClients{}
+
 	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&availabilitySetsClient.Client, auth)
-	c.availSetClient = availabilitySetsClient
+	clients.availSetClient = availabilitySetsClient
 
 	diskClient := compute.NewDisksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&diskClient.Client, auth)
-	c.diskClient = diskClient
+	clients.diskClient = diskClient
 
 	imagesClient := compute.NewImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&imagesClient.Client, auth)
-	c.imageClient = imagesClient
+	clients.imageClient = imagesClient
 
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&snapshotsClient.Client, auth)
-	c.snapshotsClient = snapshotsClient
+	clients.snapshotsClient = snapshotsClient
 
 	usageClient := compute.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
-	c.usageOpsClient = usageClient
+	clients.usageOpsClient = usageClient
 
 	extensionImagesClient := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&extensionImagesClient.Client, auth)
-	c.vmExtensionImageClient = extensionImagesClient
+	clients.vmExtensionImageClient = extensionImagesClient
 
 	extensionsClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&extensionsClient.Client, auth)
-	c.vmExtensionClient = extensionsClient
+	clients.vmExtensionClient = extensionsClient
 
 	virtualMachineImagesClient := compute.NewVirtualMachineImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachineImagesClient.Client, auth)
-	c.vmImageClient = virtualMachineImagesClient
+	clients.vmImageClient = virtualMachineImagesClient
 
 	scaleSetsClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&scaleSetsClient.Client, auth)
-	c.vmScaleSetClient = scaleSetsClient
+	clients.vmScaleSetClient = scaleSetsClient
 
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
-	c.vmClient = virtualMachinesClient
+	clients.vmClient = virtualMachinesClient
 
 	galleriesClient := compute.NewGalleriesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&galleriesClient.Client, auth)
-	c.galleriesClient = galleriesClient
+	clients.galleriesClient = galleriesClient
 
 	galleryImagesClient := compute.NewGalleryImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&galleryImagesClient.Client, auth)
-	c.galleryImagesClient = galleryImagesClient
+	clients.galleryImagesClient = galleryImagesClient
 
 	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&galleryImageVersionsClient.Client, auth)
-	c.galleryImageVersionsClient = galleryImageVersionsClient
+	clients.galleryImageVersionsClient = galleryImageVersionsClient
+
+	return &clients
+}
+
+func (c *ArmClient) containerInstance() *containerInstanceClients {
+	c.containerInstanceOnce.Do(func() {
+		c.containerInstanceClients = c.registerContainerInstanceClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.containerInstanceClients
 }
 
-func (c *ArmClient) registerContainerInstanceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerContainerInstanceClients(endpoint, subscriptionId string, auth autorest.Authorizer) *containerInstanceClients {
+	clients := containerInstanceClients{}
+
 	cgc := containerinstance.NewContainerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cgc.Client, auth)
-	c.containerGroupsClient = cgc
+	clients.containerGroupsClient = cgc
+
+	return &clients
+}
+
+func (c *ArmClient) containerRegistry() *containerRegistryClients {
+	c.containerRegistryOnce.Do(func() {
+		c.containerRegistryClients = c.registerContainerRegistryClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.containerRegistryClients
 }
 
-func (c *ArmClient) registerContainerRegistryClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerContainerRegistryClients(endpoint, subscriptionId string, auth autorest.Authorizer) *containerRegistryClients {
+	clients := containerRegistryClients{}
+
 	crc := containerregistry.NewRegistriesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&crc.Client, auth)
-	c.containerRegistryClient = crc
+	clients.containerRegistryClient = crc
 
 	// container registry replicalication client
 	crrc := containerregistry.NewReplicationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&crrc.Client, auth)
-	c.containerRegistryReplicationsClient = crrc
+	clients.containerRegistryReplicationsClient = crrc
+
+	return &clients
 }
 
-func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) containerServices() *containerServicesClients {
+	c.containerServicesOnce.Do(func() {
+		c.containerServicesClients = c.registerContainerServicesClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.containerServicesClients
+}
+
+func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId string, auth autorest.Authorizer) *containerServicesClients {
+	clients := containerServicesClients{}
+
 	// ACS
 	containerServicesClient := containerservice.NewContainerServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&containerServicesClient.Client, auth)
-	c.containerServicesClient = containerServicesClient
+	clients.containerServicesClient = containerServicesClient
 
 	// AKS
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&kubernetesClustersClient.Client, auth)
-	c.kubernetesClustersClient = kubernetesClustersClient
+	clients.kubernetesClustersClient = kubernetesClustersClient
+
+	return &clients
+}
+
+func (c *ArmClient) databricks() *databricksClients {
+	c.databricksOnce.Do(func() {
+		c.databricksClients = c.registerDatabricksClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.databricksClients
 }
 
-func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, auth autorest.Authorizer) *databricksClients {
+	clients := databricksClients{}
+
 	databricksWorkspacesClient := databricks.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&databricksWorkspacesClient.Client, auth)
-	c.databricksWorkspacesClient = databricksWorkspacesClient
+	clients.databricksWorkspacesClient = databricksWorkspacesClient
+
+	return &clients
+}
+
+func (c *ArmClient) databases() *databasesClients {
+	c.databasesOnce.Do(func() {
+		c.databasesClients = c.registerDatabases(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth, c.buildSender())
+	})
+	return c.databasesClients
 }
 
-func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) *databasesClients {
+	clients := databasesClients{}
+
 	// MySQL
 	mysqlConfigClient := mysql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlConfigClient.Client, auth)
-	c.mysqlConfigurationsClient = mysqlConfigClient
+	clients.mysqlConfigurationsClient = mysqlConfigClient
 
 	mysqlDBClient := mysql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlDBClient.Client, auth)
-	c.mysqlDatabasesClient = mysqlDBClient
+	clients.mysqlDatabasesClient = mysqlDBClient
 
 	mysqlFWClient := mysql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlFWClient.Client, auth)
-	c.mysqlFirewallRulesClient = mysqlFWClient
+	clients.mysqlFirewallRulesClient = mysqlFWClient
 
 	mysqlServersClient := mysql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlServersClient.Client, auth)
-	c.mysqlServersClient = mysqlServersClient
+	clients.mysqlServersClient = mysqlServersClient
 
 	mysqlVirtualNetworkRulesClient := mysql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mysqlVirtualNetworkRulesClient.Client, auth)
-	c.mysqlVirtualNetworkRulesClient = mysqlVirtualNetworkRulesClient
+	clients.mysqlVirtualNetworkRulesClient = mysqlVirtualNetworkRulesClient
 
 	// PostgreSQL
 	postgresqlConfigClient := postgresql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlConfigClient.Client, auth)
-	c.postgresqlConfigurationsClient = postgresqlConfigClient
+	clients.postgresqlConfigurationsClient = postgresqlConfigClient
 
 	postgresqlDBClient := postgresql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlDBClient.Client, auth)
-	c.postgresqlDatabasesClient = postgresqlDBClient
+	clients.postgresqlDatabasesClient = postgresqlDBClient
 
 	postgresqlFWClient := postgresql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlFWClient.Client, auth)
-	c.postgresqlFirewallRulesClient = postgresqlFWClient
+	clients.postgresqlFirewallRulesClient = postgresqlFWClient
 
 	postgresqlSrvClient := postgresql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlSrvClient.Client, auth)
-	c.postgresqlServersClient = postgresqlSrvClient
+	clients.postgresqlServersClient = postgresqlSrvClient
 
 	postgresqlVNRClient := postgresql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlVNRClient.Client, auth)
-	c.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
+	clients.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
 
 	// SQL Azure
 	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlDBClient.Client, auth)
-	c.sqlDatabasesClient = sqlDBClient
+	clients.sqlDatabasesClient = sqlDBClient
 
 	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDTDPClient.Client)
-	sqlDTDPClient.Authorizer = auth
-	sqlDTDPClient.Sender = sender
-	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
+	c.configureClient(&sqlDTDPClient.Client, auth)
+	clients.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlFWClient.Client, auth)
-	c.sqlFirewallRulesClient = sqlFWClient
+	clients.sqlFirewallRulesClient = sqlFWClient
 
 	sqlEPClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlEPClient.Client, auth)
-	c.sqlElasticPoolsClient = sqlEPClient
+	clients.sqlElasticPoolsClient = sqlEPClient
 
 	MsSqlEPClient := MsSql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&MsSqlEPClient.Client, auth)
-	c.msSqlElasticPoolsClient = MsSqlEPClient
+	clients.msSqlElasticPoolsClient = MsSqlEPClient
 
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlSrvClient.Client, auth)
-	c.sqlServersClient = sqlSrvClient
+	clients.sqlServersClient = sqlSrvClient
 
 	sqlADClient := sql.NewServerAzureADAdministratorsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlADClient.Client, auth)
-	c.sqlServerAzureADAdministratorsClient = sqlADClient
+	clients.sqlServerAzureADAdministratorsClient = sqlADClient
 
 	sqlVNRClient := sql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlVNRClient.Client, auth)
-	c.sqlVirtualNetworkRulesClient = sqlVNRClient
+	clients.sqlVirtualNetworkRulesClient = sqlVNRClient
+
+	return &clients
+}
+
+func (c *ArmClient) dataLake() *dataLakeClients {
+	c.dataLakeOnce.Do(func() {
+		c.dataLakeClients = c.registerDataLakeStoreClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.dataLakeClients
 }
 
-func (c *ArmClient) registerDataLakeStoreClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerDataLakeStoreClients(endpoint, subscriptionId string, auth autorest.Authorizer) *dataLakeClients {
+	clients := dataLakeClients{}
+
 	storeAccountClient := storeAccount.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&storeAccountClient.Client, auth)
-	c.dataLakeStoreAccountClient = storeAccountClient
+	clients.dataLakeStoreAccountClient = storeAccountClient
 
 	storeFirewallRulesClient := storeAccount.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&storeFirewallRulesClient.Client, auth)
-	c.dataLakeStoreFirewallRulesClient = storeFirewallRulesClient
+	clients.dataLakeStoreFirewallRulesClient = storeFirewallRulesClient
 
 	analyticsAccountClient := analyticsAccount.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&analyticsAccountClient.Client, auth)
-	c.dataLakeAnalyticsAccountClient = analyticsAccountClient
+	clients.dataLakeAnalyticsAccountClient = analyticsAccountClient
 
 	filesClient := filesystem.NewClient()
+	if c.dataLakeStoreDNSSuffix != "" {
+		filesClient.AdlsFileSystemDNSSuffix = c.dataLakeStoreDNSSuffix
+	}
 	c.configureClient(&filesClient.Client, auth)
-	c.dataLakeStoreFilesClient = filesClient
+	clients.dataLakeStoreFilesClient = filesClient
 
 	analyticsFirewallRulesClient := analyticsAccount.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&analyticsFirewallRulesClient.Client, auth)
-	c.dataLakeAnalyticsFirewallRulesClient = analyticsFirewallRulesClient
+	clients.dataLakeAnalyticsFirewallRulesClient = analyticsFirewallRulesClient
+
+	return &clients
+}
+
+func (c *ArmClient) devices() *devicesClients {
+	c.devicesOnce.Do(func() {
+		c.devicesClients = c.registerDeviceClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.devicesClients
 }
 
-func (c *ArmClient) registerDeviceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerDeviceClients(endpoint, subscriptionId string, auth autorest.Authorizer) *devicesClients {
+	clients := devicesClients{}
+
 	iotClient := devices.NewIotHubResourceClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&iotClient.Client, auth)
-	c.iothubResourceClient = iotClient
+	clients.iothubResourceClient = iotClient
+
+	return &clients
+}
+
+func (c *ArmClient) devTest() *devTestClients {
+	c.devTestOnce.Do(func() {
+		c.devTestClients = c.registerDevTestClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.devTestClients
 }
 
-func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer) *devTestClients {
+	clients := devTestClients{}
+
 	labsClient := dtl.NewLabsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&labsClient.Client, auth)
-	c.devTestLabsClient = labsClient
+	clients.devTestLabsClient = labsClient
 
 	devTestPoliciesClient := dtl.NewPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&devTestPoliciesClient.Client, auth)
-	c.devTestPoliciesClient = devTestPoliciesClient
+	clients.devTestPoliciesClient = devTestPoliciesClient
 
 	devTestVirtualMachinesClient := dtl.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&devTestVirtualMachinesClient.Client, auth)
-	c.devTestVirtualMachinesClient = devTestVirtualMachinesClient
+	clients.devTestVirtualMachinesClient = devTestVirtualMachinesClient
 
 	devTestVirtualNetworksClient := dtl.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&devTestVirtualNetworksClient.Client, auth)
-	c.devTestVirtualNetworksClient = devTestVirtualNetworksClient
+	clients.devTestVirtualNetworksClient = devTestVirtualNetworksClient
+
+	return &clients
+}
+
+func (c *ArmClient) devSpace() *devSpaceClients {
+	c.devSpaceOnce.Do(func() {
+		c.devSpaceClients = c.registerDevSpaceClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.devSpaceClients
 }
 
-func (c *ArmClient) registerDevSpaceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerDevSpaceClients(endpoint, subscriptionId string, auth autorest.Authorizer) *devSpaceClients {
+	clients := devSpaceClients{}
+
 	controllersClient := devspaces.NewControllersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&controllersClient.Client, auth)
-	c.devSpaceControllerClient = controllersClient
+	clients.devSpaceControllerClient = controllersClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) dns() *dnsClients {
+	c.dnsOnce.Do(func() {
+		c.dnsClients = c.registerDNSClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.dnsClients
+}
+
+func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer) *dnsClients {
+	clients := dnsClients{}
+
 	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dn.Client, auth)
-	c.dnsClient = dn
+	clients.dnsClient = dn
 
 	zo := dns.NewZonesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&zo.Client, auth)
-	c.zonesClient = zo
+	clients.zonesClient = zo
+
+	return &clients
+}
+
+func (c *ArmClient) eventGrid() *eventGridClients {
+	c.eventGridOnce.Do(func() {
+		c.eventGridClients = c.registerEventGridClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.eventGridClients
 }
 
-func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer) *eventGridClients {
+	clients := eventGridClients{}
+
 	egtc := eventgrid.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&egtc.Client, auth)
-	c.eventGridTopicsClient = egtc
+	clients.eventGridTopicsClient = egtc
+
+	return &clients
+}
+
+func (c *ArmClient) eventHub() *eventHubClients {
+	c.eventHubOnce.Do(func() {
+		c.eventHubClients = c.registerEventHubClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.eventHubClients
 }
 
-func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, auth autorest.Authorizer) *eventHubClients {
+	clients := eventHubClients{}
+
 	ehc := eventhub.NewEventHubsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ehc.Client, auth)
-	c.eventHubClient = ehc
+	clients.eventHubClient = ehc
 
 	chcgc := eventhub.NewConsumerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&chcgc.Client, auth)
-	c.eventHubConsumerGroupClient = chcgc
+	clients.eventHubConsumerGroupClient = chcgc
 
 	ehnc := eventhub.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ehnc.Client, auth)
-	c.eventHubNamespacesClient = ehnc
+	clients.eventHubNamespacesClient = ehnc
+
+	return &clients
+}
+
+func (c *ArmClient) keyVault() *keyVaultClients {
+	c.keyVaultOnce.Do(func() {
+		c.keyVaultClients = c.registerKeyVaultClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth, c.keyVaultAuth)
+	})
+	return c.keyVaultClients
 }
 
-func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer) {
+func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer) *keyVaultClients {
+	clients := keyVaultClients{}
+
 	keyVaultClient := keyvault.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&keyVaultClient.Client, auth)
-	c.keyVaultClient = keyVaultClient
+	clients.keyVaultClient = keyVaultClient
 
 	keyVaultManagementClient := keyVault.New()
 	c.configureClient(&keyVaultManagementClient.Client, keyVaultAuth)
-	c.keyVaultManagementClient = keyVaultManagementClient
+	clients.keyVaultManagementClient = keyVaultManagementClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) logic() *logicClients {
+	c.logicOnce.Do(func() {
+		c.logicClients = c.registerLogicClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.logicClients
+}
+
+func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth autorest.Authorizer) *logicClients {
+	clients := logicClients{}
+
 	workflowsClient := logic.NewWorkflowsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&workflowsClient.Client, auth)
-	c.logicWorkflowsClient = workflowsClient
+	clients.logicWorkflowsClient = workflowsClient
+
+	return &clients
+}
+
+func (c *ArmClient) monitor() *monitorClients {
+	c.monitorOnce.Do(func() {
+		c.monitorClients = c.registerMonitorClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.monitorClients
 }
 
-func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer) *monitorClients {
+	clients := monitorClients{}
+
 	agc := insights.NewActionGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&agc.Client, auth)
-	c.monitorActionGroupsClient = agc
+	clients.monitorActionGroupsClient = agc
 
 	alac := insights.NewActivityLogAlertsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&alac.Client, auth)
-	c.monitorActivityLogAlertsClient = alac
+	clients.monitorActivityLogAlertsClient = alac
 
 	arc := insights.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&arc.Client, auth)
-	c.monitorAlertRulesClient = arc
+	clients.monitorAlertRulesClient = arc
 
 	monitorLogProfilesClient := insights.NewLogProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&monitorLogProfilesClient.Client, auth)
-	c.monitorLogProfilesClient = monitorLogProfilesClient
+	clients.monitorLogProfilesClient = monitorLogProfilesClient
 
 	mac := insights.NewMetricAlertsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&mac.Client, auth)
-	c.monitorMetricAlertsClient = mac
+	clients.monitorMetricAlertsClient = mac
 
 	autoscaleSettingsClient := insights.NewAutoscaleSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&autoscaleSettingsClient.Client, auth)
-	c.autoscaleSettingsClient = autoscaleSettingsClient
+	clients.autoscaleSettingsClient = autoscaleSettingsClient
 
 	monitoringInsightsClient := insights.NewDiagnosticSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&monitoringInsightsClient.Client, auth)
-	c.monitorDiagnosticSettingsClient = monitoringInsightsClient
+	clients.monitorDiagnosticSettingsClient = monitoringInsightsClient
 
 	monitoringCategorySettingsClient := insights.NewDiagnosticSettingsCategoryClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&monitoringCategorySettingsClient.Client, auth)
-	c.monitorDiagnosticSettingsCategoryClient = monitoringCategorySettingsClient
+	clients.monitorDiagnosticSettingsCategoryClient = monitoringCategorySettingsClient
+
+	return &clients
+}
+
+func (c *ArmClient) network() *networkClients {
+	c.networkOnce.Do(func() {
+		c.networkClients = c.registerNetworkingClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.networkClients
 }
 
-func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, auth autorest.Authorizer) *networkClients {
+	clients := networkClients{}
+
 	applicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&applicationGatewaysClient.Client, auth)
-	c.applicationGatewayClient = applicationGatewaysClient
+	clients.applicationGatewayClient = applicationGatewaysClient
 
 	appSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appSecurityGroupsClient.Client, auth)
-	c.applicationSecurityGroupsClient = appSecurityGroupsClient
+	clients.applicationSecurityGroupsClient = appSecurityGroupsClient
 
 	azureFirewallsClient := network.NewAzureFirewallsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&azureFirewallsClient.Client, auth)
-	c.azureFirewallsClient = azureFirewallsClient
+	clients.azureFirewallsClient = azureFirewallsClient
 
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
-	c.expressRouteAuthsClient = expressRouteAuthsClient
+	clients.expressRouteAuthsClient = expressRouteAuthsClient
 
 	expressRouteCircuitsClient := network.NewExpressRouteCircuitsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteCircuitsClient.Client, auth)
-	c.expressRouteCircuitClient = expressRouteCircuitsClient
+	clients.expressRouteCircuitClient = expressRouteCircuitsClient
 
 	expressRoutePeeringsClient := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRoutePeeringsClient.Client, auth)
-	c.expressRoutePeeringsClient = expressRoutePeeringsClient
+	clients.expressRoutePeeringsClient = expressRoutePeeringsClient
 
 	interfacesClient := network.NewInterfacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&interfacesClient.Client, auth)
-	c.ifaceClient = interfacesClient
+	clients.ifaceClient = interfacesClient
 
 	loadBalancersClient := network.NewLoadBalancersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&loadBalancersClient.Client, auth)
-	c.loadBalancerClient = loadBalancersClient
+	clients.loadBalancerClient = loadBalancersClient
 
 	localNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&localNetworkGatewaysClient.Client, auth)
-	c.localNetConnClient = localNetworkGatewaysClient
+	clients.localNetConnClient = localNetworkGatewaysClient
 
 	gatewaysClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewaysClient.Client, auth)
-	c.vnetGatewayClient = gatewaysClient
+	clients.vnetGatewayClient = gatewaysClient
 
 	gatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewayConnectionsClient.Client, auth)
-	c.vnetGatewayConnectionsClient = gatewayConnectionsClient
+	clients.vnetGatewayConnectionsClient = gatewayConnectionsClient
 
 	networksClient := network.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&networksClient.Client, auth)
-	c.vnetClient = networksClient
+	clients.vnetClient = networksClient
 
 	packetCapturesClient := network.NewPacketCapturesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&packetCapturesClient.Client, auth)
-	c.packetCapturesClient = packetCapturesClient
+	clients.packetCapturesClient = packetCapturesClient
 
 	peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&peeringsClient.Client, auth)
-	c.vnetPeeringsClient = peeringsClient
+	clients.vnetPeeringsClient = peeringsClient
 
 	publicIPAddressesClient := network.NewPublicIPAddressesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&publicIPAddressesClient.Client, auth)
-	c.publicIPClient = publicIPAddressesClient
+	clients.publicIPClient = publicIPAddressesClient
 
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
-	c.routesClient = routesClient
+	clients.routesClient = routesClient
 
 	routeTablesClient := network.NewRouteTablesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routeTablesClient.Client, auth)
-	c.routeTablesClient = routeTablesClient
+	clients.routeTablesClient = routeTablesClient
 
 	securityGroupsClient := network.NewSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&securityGroupsClient.Client, auth)
-	c.secGroupClient = securityGroupsClient
+	clients.secGroupClient = securityGroupsClient
 
 	securityRulesClient := network.NewSecurityRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&securityRulesClient.Client, auth)
-	c.secRuleClient = securityRulesClient
+	clients.secRuleClient = securityRulesClient
 
 	subnetsClient := network.NewSubnetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subnetsClient.Client, auth)
-	c.subnetClient = subnetsClient
+	clients.subnetClient = subnetsClient
 
 	userAssignedIdentitiesClient := msi.NewUserAssignedIdentitiesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
-	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
+	clients.userAssignedIdentitiesClient = userAssignedIdentitiesClient
 
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
-	c.watcherClient = watchersClient
+	clients.watcherClient = watchersClient
+
+	return &clients
+}
+
+func (c *ArmClient) notificationHubs() *notificationHubsClients {
+	c.notificationHubsOnce.Do(func() {
+		c.notificationHubsClients = c.registerNotificationHubsClient(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.notificationHubsClients
 }
 
-func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer) *notificationHubsClients {
+	clients := notificationHubsClients{}
+
 	namespacesClient := notificationhubs.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
-	c.notificationNamespacesClient = namespacesClient
+	clients.notificationNamespacesClient = namespacesClient
 
 	notificationHubsClient := notificationhubs.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&notificationHubsClient.Client, auth)
-	c.notificationHubsClient = notificationHubsClient
+	clients.notificationHubsClient = notificationHubsClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerOperationalInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) operationalInsights() *operationalInsightsClients {
+	c.operationalInsightsOnce.Do(func() {
+		c.operationalInsightsClients = c.registerOperationalInsightsClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.operationalInsightsClients
+}
+
+func (c *ArmClient) registerOperationalInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer) *operationalInsightsClients {
+	clients := operationalInsightsClients{}
+
 	opwc := operationalinsights.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&opwc.Client, auth)
-	c.workspacesClient = opwc
+	clients.workspacesClient = opwc
 
 	solutionsClient := operationsmanagement.NewSolutionsClientWithBaseURI(endpoint, subscriptionId, "Microsoft.OperationsManagement", "solutions", "testing")
 	c.configureClient(&solutionsClient.Client, auth)
-	c.solutionsClient = solutionsClient
+	clients.solutionsClient = solutionsClient
 
 	lsClient := operationalinsights.NewLinkedServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&lsClient.Client, auth)
-	c.linkedServicesClient = lsClient
+	clients.linkedServicesClient = lsClient
+
+	return &clients
+}
+
+func (c *ArmClient) recoveryServices() *recoveryServicesClients {
+	c.recoveryServicesOnce.Do(func() {
+		c.recoveryServicesClients = c.registerRecoveryServiceClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.recoveryServicesClients
 }
 
-func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) *recoveryServicesClients {
+	clients := recoveryServicesClients{}
+
 	vaultsClient := recoveryservices.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&vaultsClient.Client, auth)
-	c.recoveryServicesVaultsClient = vaultsClient
+	clients.recoveryServicesVaultsClient = vaultsClient
 
 	protectedItemsClient := backup.NewProtectedItemsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectedItemsClient.Client, auth)
-	c.recoveryServicesProtectedItemsClient = protectedItemsClient
+	clients.recoveryServicesProtectedItemsClient = protectedItemsClient
 
 	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectionPoliciesClient.Client, auth)
-	c.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+	clients.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+
+	return &clients
+}
+
+func (c *ArmClient) redis() *redisClients {
+	c.redisOnce.Do(func() {
+		c.redisClients = c.registerRedisClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.redisClients
 }
 
-func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer) *redisClients {
+	clients := redisClients{}
+
 	redisClient := redis.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&redisClient.Client, auth)
-	c.redisClient = redisClient
+	clients.redisClient = redisClient
 
 	firewallRuleClient := redis.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&firewallRuleClient.Client, auth)
-	c.redisFirewallClient = firewallRuleClient
+	clients.redisFirewallClient = firewallRuleClient
 
 	patchSchedulesClient := redis.NewPatchSchedulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&patchSchedulesClient.Client, auth)
-	c.redisPatchSchedulesClient = patchSchedulesClient
+	clients.redisPatchSchedulesClient = patchSchedulesClient
+
+	return &clients
+}
+
+func (c *ArmClient) relay() *relayClients {
+	c.relayOnce.Do(func() {
+		c.relayClients = c.registerRelayClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.relayClients
 }
 
-func (c *ArmClient) registerRelayClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerRelayClients(endpoint, subscriptionId string, auth autorest.Authorizer) *relayClients {
+	clients := relayClients{}
+
 	relayNamespacesClient := relay.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&relayNamespacesClient.Client, auth)
-	c.relayNamespacesClient = relayNamespacesClient
+	clients.relayNamespacesClient = relayNamespacesClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) resources() *resourcesClients {
+	c.resourcesOnce.Do(func() {
+		c.resourcesClients = c.registerResourcesClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.resourcesClients
+}
+
+func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, auth autorest.Authorizer) *resourcesClients {
+	clients := resourcesClients{}
+
 	locksClient := locks.NewManagementLocksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&locksClient.Client, auth)
-	c.managementLocksClient = locksClient
+	clients.managementLocksClient = locksClient
 
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&deploymentsClient.Client, auth)
-	c.deploymentsClient = deploymentsClient
+	clients.deploymentsClient = deploymentsClient
+
+	subscriptionDeploymentsClient := newSubscriptionDeploymentsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&subscriptionDeploymentsClient.Client, auth)
+	clients.subscriptionDeploymentsClient = subscriptionDeploymentsClient
 
 	resourcesClient := resources.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourcesClient.Client, auth)
-	c.resourcesClient = resourcesClient
+	clients.resourcesClient = resourcesClient
 
 	resourceGroupsClient := resources.NewGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourceGroupsClient.Client, auth)
-	c.resourceGroupsClient = resourceGroupsClient
+	clients.resourceGroupsClient = resourceGroupsClient
 
 	providersClient := resources.NewProvidersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&providersClient.Client, auth)
-	c.providersClient = providersClient
+	clients.providersClient = providersClient
 
 	subscriptionsClient := subscriptions.NewClientWithBaseURI(endpoint)
 	c.configureClient(&subscriptionsClient.Client, auth)
-	c.subscriptionsClient = subscriptionsClient
+	clients.subscriptionsClient = subscriptionsClient
+
+	return &clients
+}
+
+func (c *ArmClient) scheduler() *schedulerClients {
+	c.schedulerOnce.Do(func() {
+		c.schedulerClients = c.registerSchedulerClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.schedulerClients
 }
 
-func (c *ArmClient) registerSchedulerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerSchedulerClients(endpoint, subscriptionId string, auth autorest.Authorizer) *schedulerClients {
+	clients := schedulerClients{}
+
 	jobCollectionsClient := scheduler.NewJobCollectionsClientWithBaseURI(endpoint, subscriptionId) //nolint: megacheck
 	c.configureClient(&jobCollectionsClient.Client, auth)
-	c.schedulerJobCollectionsClient = jobCollectionsClient
+	clients.schedulerJobCollectionsClient = jobCollectionsClient
 
 	jobsClient := scheduler.NewJobsClientWithBaseURI(endpoint, subscriptionId) //nolint: megacheck
 	c.configureClient(&jobsClient.Client, auth)
-	c.schedulerJobsClient = jobsClient
+	clients.schedulerJobsClient = jobsClient
+
+	return &clients
+}
+
+func (c *ArmClient) search() *searchClients {
+	c.searchOnce.Do(func() {
+		c.searchClients = c.registerSearchClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.searchClients
 }
 
-func (c *ArmClient) registerSearchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerSearchClients(endpoint, subscriptionId string, auth autorest.Authorizer) *searchClients {
+	clients := searchClients{}
+
 	searchClient := search.NewServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&searchClient.Client, auth)
-	c.searchServicesClient = searchClient
+	clients.searchServicesClient = searchClient
 
 	searchAdminKeysClient := search.NewAdminKeysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&searchAdminKeysClient.Client, auth)
-	c.searchAdminKeysClient = searchAdminKeysClient
+	clients.searchAdminKeysClient = searchAdminKeysClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerSecurityCenterClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) securityCenter() *securityCenterClients {
+	c.securityCenterOnce.Do(func() {
+		c.securityCenterClients = c.registerSecurityCenterClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.securityCenterClients
+}
+
+func (c *ArmClient) registerSecurityCenterClients(endpoint, subscriptionId string, auth autorest.Authorizer) *securityCenterClients {
+	clients := securityCenterClients{}
+
 	ascLocation := "Global"
 
 	securityCenterPricingClient := security.NewPricingsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
 	c.configureClient(&securityCenterPricingClient.Client, auth)
-	c.securityCenterPricingClient = securityCenterPricingClient
+	clients.securityCenterPricingClient = securityCenterPricingClient
 
 	securityCenterContactsClient := security.NewContactsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
 	c.configureClient(&securityCenterContactsClient.Client, auth)
-	c.securityCenterContactsClient = securityCenterContactsClient
+	clients.securityCenterContactsClient = securityCenterContactsClient
 
 	securityCenterWorkspaceClient := security.NewWorkspaceSettingsClientWithBaseURI(endpoint, subscriptionId, ascLocation)
 	c.configureClient(&securityCenterWorkspaceClient.Client, auth)
-	c.securityCenterWorkspaceClient = securityCenterWorkspaceClient
+	clients.securityCenterWorkspaceClient = securityCenterWorkspaceClient
+
+	return &clients
+}
+
+func (c *ArmClient) serviceBus() *serviceBusClients {
+	c.serviceBusOnce.Do(func() {
+		c.serviceBusClients = c.registerServiceBusClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.serviceBusClients
 }
 
-func (c *ArmClient) registerServiceBusClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerServiceBusClients(endpoint, subscriptionId string, auth autorest.Authorizer) *serviceBusClients {
+	clients := serviceBusClients{}
+
 	queuesClient := servicebus.NewQueuesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&queuesClient.Client, auth)
-	c.serviceBusQueuesClient = queuesClient
+	clients.serviceBusQueuesClient = queuesClient
 
 	namespacesClient := servicebus.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
-	c.serviceBusNamespacesClient = namespacesClient
+	clients.serviceBusNamespacesClient = namespacesClient
 
 	topicsClient := servicebus.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&topicsClient.Client, auth)
-	c.serviceBusTopicsClient = topicsClient
+	clients.serviceBusTopicsClient = topicsClient
 
 	subscriptionsClient := servicebus.NewSubscriptionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subscriptionsClient.Client, auth)
-	c.serviceBusSubscriptionsClient = subscriptionsClient
+	clients.serviceBusSubscriptionsClient = subscriptionsClient
 
 	subscriptionRulesClient := servicebus.NewRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subscriptionRulesClient.Client, auth)
-	c.serviceBusSubscriptionRulesClient = subscriptionRulesClient
+	clients.serviceBusSubscriptionRulesClient = subscriptionRulesClient
+
+	return &clients
+}
+
+func (c *ArmClient) serviceFabric() *serviceFabricClients {
+	c.serviceFabricOnce.Do(func() {
+		c.serviceFabricClients = c.registerServiceFabricClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.serviceFabricClients
 }
 
-func (c *ArmClient) registerServiceFabricClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerServiceFabricClients(endpoint, subscriptionId string, auth autorest.Authorizer) *serviceFabricClients {
+	clients := serviceFabricClients{}
+
 	clustersClient := servicefabric.NewClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&clustersClient.Client, auth)
-	c.serviceFabricClustersClient = clustersClient
+	clients.serviceFabricClustersClient = clustersClient
+
+	return &clients
+}
+
+func (c *ArmClient) storage() *storageClients {
+	c.storageOnce.Do(func() {
+		c.storageClients = c.registerStorageClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.storageClients
 }
 
-func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth autorest.Authorizer) *storageClients {
+	clients := storageClients{}
+
 	accountsClient := storage.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&accountsClient.Client, auth)
-	c.storageServiceClient = accountsClient
+	clients.storageServiceClient = accountsClient
 
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
-	c.storageUsageClient = usageClient
+	clients.storageUsageClient = usageClient
+
+	accountServicesClient := newStorageAccountServicesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&accountServicesClient.Client, auth)
+	clients.storageAccountServicesClient = accountServicesClient
+
+	managementPoliciesClient := newStorageManagementPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&managementPoliciesClient.Client, auth)
+	clients.storageManagementPoliciesClient = managementPoliciesClient
+
+	return &clients
+}
+
+func (c *ArmClient) trafficManager() *trafficManagerClients {
+	c.trafficManagerOnce.Do(func() {
+		c.trafficManagerClients = c.registerTrafficManagerClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.trafficManagerClients
 }
 
-func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) *trafficManagerClients {
+	clients := trafficManagerClients{}
+
 	endpointsClient := trafficmanager.NewEndpointsClientWithBaseURI(endpoint, c.subscriptionId)
 	c.configureClient(&endpointsClient.Client, auth)
-	c.trafficManagerEndpointsClient = endpointsClient
+	clients.trafficManagerEndpointsClient = endpointsClient
 
 	geographicalHierarchiesClient := trafficmanager.NewGeographicHierarchiesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&geographicalHierarchiesClient.Client, auth)
-	c.trafficManagerGeographialHierarchiesClient = geographicalHierarchiesClient
+	clients.trafficManagerGeographialHierarchiesClient = geographicalHierarchiesClient
 
 	profilesClient := trafficmanager.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&profilesClient.Client, auth)
-	c.trafficManagerProfilesClient = profilesClient
+	clients.trafficManagerProfilesClient = profilesClient
+
+	return &clients
+}
+
+func (c *ArmClient) web() *webClients {
+	c.webOnce.Do(func() {
+		c.webClients = c.registerWebClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.webClients
 }
 
-func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) *webClients {
+	clients := webClients{}
+
 	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appServicePlansClient.Client, auth)
-	c.appServicePlansClient = appServicePlansClient
+	clients.appServicePlansClient = appServicePlansClient
 
 	appsClient := web.NewAppsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appsClient.Client, auth)
-	c.appServicesClient = appsClient
+	clients.appServicesClient = appsClient
+
+	return &clients
 }
 
-func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) policy() *policyClients {
+	c.policyOnce.Do(func() {
+		c.policyClients = c.registerPolicyClients(c.resourceManagerEndpoint, c.subscriptionId, c.resourceManagerAuth)
+	})
+	return c.policyClients
+}
+
+func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth autorest.Authorizer) *policyClients {
+	clients := policyClients{}
+
 	policyAssignmentsClient := policy.NewAssignmentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&policyAssignmentsClient.Client, auth)
-	c.policyAssignmentsClient = policyAssignmentsClient
+	clients.policyAssignmentsClient = policyAssignmentsClient
 
 	policyDefinitionsClient := policy.NewDefinitionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&policyDefinitionsClient.Client, auth)
-	c.policyDefinitionsClient = policyDefinitionsClient
+	clients.policyDefinitionsClient = policyDefinitionsClient
+
+	return &clients
+}
+
+func (c *ArmClient) managementGroups() *managementGroupsClients {
+	c.managementGroupsOnce.Do(func() {
+		c.managementGroupsClients = c.registerManagementGroupClients(c.resourceManagerEndpoint, c.resourceManagerAuth)
+	})
+	return c.managementGroupsClients
 }
 
-func (c *ArmClient) registerManagementGroupClients(endpoint string, auth autorest.Authorizer) {
+func (c *ArmClient) registerManagementGroupClients(endpoint string, auth autorest.Authorizer) *managementGroupsClients {
+	clients := managementGroupsClients{}
+
 	managementGroupsClient := managementgroups.NewClientWithBaseURI(endpoint)
 	c.configureClient(&managementGroupsClient.Client, auth)
-	c.managementGroupsClient = managementGroupsClient
+	clients.managementGroupsClient = managementGroupsClient
 
 	managementGroupsSubscriptionClient := managementgroups.NewSubscriptionsClientWithBaseURI(endpoint)
 	c.configureClient(&managementGroupsSubscriptionClient.Client, auth)
-	c.managementGroupsSubscriptionClient = managementGroupsSubscriptionClient
+	clients.managementGroupsSubscriptionClient = managementGroupsSubscriptionClient
+
+	return &clients
 }
 
+const (
+	// storageResourceIdentifier is the audience of the tokens used to authenticate against the Storage data plane
+	storageResourceIdentifier = "https://storage.azure.com/"
+
+	// storageAzureADAPIVersion is the API version used when authenticating using Azure Active Directory, which
+	// isn't supported by the (older) default API version of the Storage SDK
+	storageAzureADAPIVersion = "2017-11-09"
+
+	// storageAzureADPlaceholderKey is a (base64-encoded) placeholder for the Access Key, which the Storage SDK
+	// requires to sign each request - but is replaced by a bearer token when using Azure Active Directory
+	storageAzureADPlaceholderKey = "YXp1cmVhZA=="
+)
+
 var (
 	storageKeyCacheMu sync.RWMutex
 	storageKeyCache   = make(map[string]string)
@@ -1161,7 +1943,7 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	defer storageKeyCacheMu.Unlock()
 	key, ok = storageKeyCache[cacheIndex]
 	if !ok {
-		accountKeys, err := c.storageServiceClient.ListKeys(ctx, resourceGroupName, storageAccountName)
+		accountKeys, err := c.storage().storageServiceClient.ListKeys(ctx, resourceGroupName, storageAccountName)
 		if utils.ResponseWasNotFound(accountKeys.Response) {
 			return "", false, nil
 		}
@@ -1192,7 +1974,37 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	return key, true, nil
 }
 
-func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+// getStorageClientForStorageAccount returns a client for the Storage Account's data plane, which is authenticated using
+// Azure Active Directory when this is enabled for the Provider and supported by the service - and otherwise using the
+// Storage Account's Access Key
+func (c *ArmClient) getStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string, supportsAzureAD bool) (*mainStorage.Client, bool, error) {
+	if c.storageUseAzureAD && supportsAzureAD {
+		account, err := c.storage().storageServiceClient.GetProperties(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			if utils.ResponseWasNotFound(account.Response) {
+				return nil, false, nil
+			}
+
+			// We assume this is a transient error rather than a 404 (which is caught above), so assume the
+			// storeAccount still exists.
+			return nil, true, fmt.Errorf("Error retrieving storage storeAccount %q: %s", storageAccountName, err)
+		}
+
+		// the Access Key is only used to sign the request, which is then replaced by the bearer token - as such
+		// a placeholder is used, since the principal may not be able to list the keys (or these may be disabled)
+		storageClient, err := mainStorage.NewClient(storageAccountName, storageAzureADPlaceholderKey, c.environment.StorageEndpointSuffix,
+			storageAzureADAPIVersion, true)
+		if err != nil {
+			return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+		}
+		storageClient.Sender = storageSender{
+			sender:     c.buildSender(),
+			authorizer: c.storageAuth,
+		}
+
+		return &storageClient, true, nil
+	}
+
 	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
 		return nil, accountExists, err
@@ -1206,63 +2018,127 @@ func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, r
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
 	}
+	storageClient.Sender = storageSender{sender: c.buildSender()}
+
+	return &storageClient, true, nil
+}
+
+// getStaticWebsiteClientForStorageAccount returns a client for the Static Website of the Storage Account, which is
+// authenticated in the same way as the other Storage data plane clients
+func (c *ArmClient) getStaticWebsiteClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageStaticWebsiteClient, bool, error) {
+	var authorizer autorest.Authorizer
+
+	if c.storageUseAzureAD {
+		account, err := c.storage().storageServiceClient.GetProperties(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			if utils.ResponseWasNotFound(account.Response) {
+				return nil, false, nil
+			}
+
+			return nil, true, fmt.Errorf("Error retrieving storage storeAccount %q: %s", storageAccountName, err)
+		}
+
+		authorizer = c.storageAuth
+	} else {
+		key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return nil, accountExists, err
+		}
+		if !accountExists {
+			return nil, false, nil
+		}
+
+		authorizer, err = newStorageSharedKeyAuthorizer(storageAccountName, key)
+		if err != nil {
+			return nil, true, err
+		}
+	}
+
+	client := newStorageStaticWebsiteClient(fmt.Sprintf("https://%s.blob.%s", storageAccountName, c.environment.StorageEndpointSuffix))
+	c.configureStorageClient(&client.Client, authorizer)
+	return &client, true, nil
+}
+
+// getSignedIdentifiersClientForStorageAccount returns a client for the Stored Access Policies of the specified
+// service (one of `blob`, `file`, `queue` or `table`) - only the Blob and Queue Services support authenticating
+// using Azure Active Directory, as such the File and Table Services always authenticate using the Access Key
+func (c *ArmClient) getSignedIdentifiersClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, serviceName, resourceType string) (*storageSignedIdentifiersClient, bool, error) {
+	var authorizer autorest.Authorizer
+
+	if c.storageUseAzureAD && (serviceName == "blob" || serviceName == "queue") {
+		account, err := c.storage().storageServiceClient.GetProperties(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			if utils.ResponseWasNotFound(account.Response) {
+				return nil, false, nil
+			}
+
+			return nil, true, fmt.Errorf("Error retrieving storage storeAccount %q: %s", storageAccountName, err)
+		}
+
+		authorizer = c.storageAuth
+	} else {
+		key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return nil, accountExists, err
+		}
+		if !accountExists {
+			return nil, false, nil
+		}
+
+		if serviceName == "table" {
+			authorizer, err = newStorageTableSharedKeyAuthorizer(storageAccountName, key)
+		} else {
+			authorizer, err = newStorageSharedKeyAuthorizer(storageAccountName, key)
+		}
+		if err != nil {
+			return nil, true, err
+		}
+	}
+
+	client := newStorageSignedIdentifiersClient(fmt.Sprintf("https://%s.%s.%s", storageAccountName, serviceName, c.environment.StorageEndpointSuffix), resourceType)
+	c.configureStorageClient(&client.Client, authorizer)
+	return &client, true, nil
+}
+
+func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, true)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
+	}
 
 	blobClient := storageClient.GetBlobService()
 	return &blobClient, true, nil
 }
 
+// getFileServiceClientForStorageAccount always authenticates using the Access Key, since Azure Active Directory
+// authentication isn't supported by the File Service's REST API
 func (c *ArmClient) getFileServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.FileServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, false)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
-	}
-
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
-	}
 
 	fileClient := storageClient.GetFileService()
 	return &fileClient, true, nil
 }
 
+// getTableServiceClientForStorageAccount always authenticates using the Access Key, since Azure Active Directory
+// authentication isn't supported by the Table Service
 func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.TableServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, false)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
-	}
-
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
-	}
 
 	tableClient := storageClient.GetTableService()
 	return &tableClient, true, nil
 }
 
 func (c *ArmClient) getQueueServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.QueueServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, true)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
-	}
-
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
-	}
 
 	queueClient := storageClient.GetQueueService()
 	return &queueClient, true, nil
@@ -1,16 +1,445 @@
 package azurerm
 
-import "testing"
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
+)
 
 func TestClientRequestID(t *testing.T) {
-	first := clientRequestID()
+	requests := make([]*http.Request, 0)
+	recorder := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req)
+		return &http.Response{StatusCode: http.StatusOK, Request: req}, nil
+	})
+	sender := azure.DecorateSender(recorder, azure.DefaultRetryPolicy(), nil)
+
+	op := correlation.NewOperation()
+	ctx := correlation.NewContext(context.Background(), op)
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		if _, err := sender.Do(req.WithContext(ctx)); err != nil {
+			t.Fatalf("Error sending request: %+v", err)
+		}
+	}
 
+	first := requests[0].Header.Get(correlation.ClientRequestIDHeader)
 	if first == "" {
 		t.Fatal("no client request ID generated")
 	}
 
-	second := clientRequestID()
+	second := requests[1].Header.Get(correlation.ClientRequestIDHeader)
 	if first != second {
 		t.Fatal("subsequent request ID not the same as the first")
 	}
+
+	if first != op.ClientRequestID {
+		t.Fatalf("Expected the client request ID to be %q but got %q", op.ClientRequestID, first)
+	}
+}
+
+func TestArmClientBuildsClientsOnFirstUse(t *testing.T) {
+	builder := authentication.Builder{
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		ClientID:                 "11111111-1111-1111-1111-111111111111",
+		ClientSecret:             "not-a-real-secret",
+		TenantID:                 "22222222-2222-2222-2222-222222222222",
+		Environment:              "public",
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	client, err := getArmClient(config, armClientOptions{
+		skipProviderRegistration: true,
+		retryPolicy:              azure.DefaultRetryPolicy(),
+	})
+	if err != nil {
+		t.Fatalf("Error building ARM Client: %+v", err)
+	}
+
+	if client.graphClients != nil || client.computeClients != nil {
+		t.Fatalf("Expected no clients to be built until they're used")
+	}
+
+	graph := client.graph()
+	if graph == nil || client.graphClients == nil {
+		t.Fatalf("Expected the Graph clients to be built on first use")
+	}
+
+	if graph.applicationsClient.Authorizer != client.graphAuth {
+		t.Fatalf("Expected the Graph clients to use the Graph Authorizer")
+	}
+
+	if client.graph() != graph {
+		t.Fatalf("Expected the Graph clients to only be built once")
+	}
+
+	if client.computeClients != nil {
+		t.Fatalf("Expected the Compute clients not to be built when only the Graph clients are used")
+	}
+}
+
+func TestArmClientUsesCustomEnvironment(t *testing.T) {
+	builder := authentication.Builder{
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		ClientID:                 "11111111-1111-1111-1111-111111111111",
+		ClientSecret:             "not-a-real-secret",
+		TenantID:                 "22222222-2222-2222-2222-222222222222",
+		Environment:              "public",
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	customEnvironment := azure.Environment{
+		Environment: az.Environment{
+			Name:                    "ExampleCloud",
+			ResourceManagerEndpoint: "https://management.example.cloud/",
+			ActiveDirectoryEndpoint: "https://login.example.cloud/",
+			GraphEndpoint:           "https://graph.example.cloud/",
+			TokenAudience:           "https://management.example.cloud/",
+			StorageEndpointSuffix:   "core.example.cloud",
+		},
+		DataLakeStoreDNSSuffix: "datalakestore.example.cloud",
+	}
+
+	client, err := getArmClient(config, armClientOptions{
+		skipProviderRegistration: true,
+		retryPolicy:              azure.DefaultRetryPolicy(),
+		customEnvironment:        &customEnvironment,
+	})
+	if err != nil {
+		t.Fatalf("Error building ARM Client: %+v", err)
+	}
+
+	if client.environment.StorageEndpointSuffix != "core.example.cloud" {
+		t.Fatalf("Expected the Storage Endpoint Suffix to be %q but got %q", "core.example.cloud", client.environment.StorageEndpointSuffix)
+	}
+
+	if actual := client.resources().resourceGroupsClient.BaseURI; actual != "https://management.example.cloud/" {
+		t.Fatalf("Expected the Resource Manager clients to use %q but got %q", "https://management.example.cloud/", actual)
+	}
+
+	if actual := client.graph().applicationsClient.BaseURI; actual != "https://graph.example.cloud/" {
+		t.Fatalf("Expected the Graph clients to use %q but got %q", "https://graph.example.cloud/", actual)
+	}
+
+	if actual := client.dataLake().dataLakeStoreFilesClient.AdlsFileSystemDNSSuffix; actual != "datalakestore.example.cloud" {
+		t.Fatalf("Expected the Data Lake Store DNS Suffix to be %q but got %q", "datalakestore.example.cloud", actual)
+	}
+}
+
+type testCountingSender struct {
+	requests int
+}
+
+func (s *testCountingSender) Do(req *http.Request) (*http.Response, error) {
+	s.requests++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"name": "example-resources"}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestConfigureClientSendsRequests(t *testing.T) {
+	builder := authentication.Builder{
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		ClientID:                 "11111111-1111-1111-1111-111111111111",
+		ClientSecret:             "not-a-real-secret",
+		TenantID:                 "22222222-2222-2222-2222-222222222222",
+		Environment:              "public",
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	client, err := getArmClient(config, armClientOptions{
+		skipProviderRegistration: true,
+		retryPolicy:              azure.DefaultRetryPolicy(),
+	})
+	if err != nil {
+		t.Fatalf("Error building ARM Client: %+v", err)
+	}
+
+	// the SDK sends requests using `DoRetryWithRegistration`, which is bound by the settings from `configureClient`
+	sender := &testCountingSender{}
+	groupsClient := client.resources().resourceGroupsClient
+	groupsClient.Authorizer = autorest.NullAuthorizer{}
+	groupsClient.Sender = sender
+
+	group, err := groupsClient.Get(context.Background(), "example-resources")
+	if err != nil {
+		t.Fatalf("Error retrieving Resource Group: %+v", err)
+	}
+
+	if sender.requests != 1 {
+		t.Fatalf("Expected a single request to be sent but got %d", sender.requests)
+	}
+
+	if group.Name == nil || *group.Name != "example-resources" {
+		t.Fatalf("Expected the response to be unmarshalled but got %+v", group.Name)
+	}
+}
+
+type testResourceManagerResponse struct {
+	method     string
+	path       string
+	statusCode int
+	body       string
+}
+
+// testResourceManagerServer returns a server which returns each response in turn (repeating the last one) and
+// records the requests it receives, such that the number of times each request is sent can be asserted
+func testResourceManagerServer(t *testing.T, responses []testResourceManagerResponse, requests *[]string) *httptest.Server {
+	lock := sync.Mutex{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		response := responses[len(responses)-1]
+		if len(*requests) < len(responses) {
+			response = responses[len(*requests)]
+		}
+		*requests = append(*requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+
+		if !strings.EqualFold(r.Method, response.method) || !strings.EqualFold(r.URL.Path, response.path) {
+			t.Errorf("Expected request %d to be %q but got %q", len(*requests), fmt.Sprintf("%s %s", response.method, response.path), fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(response.statusCode)
+		fmt.Fprint(w, response.body)
+	}))
+}
+
+func TestConfigureClientRetries(t *testing.T) {
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	groupPath := fmt.Sprintf("/subscriptions/%s/resourcegroups/example-resources", subscriptionId)
+	providerPath := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Resources", subscriptionId)
+	group := `{"name": "example-resources"}`
+	missingRegistration := `{"error": {"code": "MissingSubscriptionRegistration", "message": "The subscription is not registered to use namespace 'Microsoft.Resources'.", "details": [{"code": "MissingSubscriptionRegistration", "target": "Microsoft.Resources"}]}}`
+
+	cases := []struct {
+		Name             string
+		Excluded         []string
+		Responses        []testResourceManagerResponse
+		ExpectedRequests int
+		ExpectError      bool
+	}{
+		{
+			Name: "Throttled then Succeeded",
+			Responses: []testResourceManagerResponse{
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusTooManyRequests, body: `{}`},
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusTooManyRequests, body: `{}`},
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusOK, body: group},
+			},
+			ExpectedRequests: 3,
+		},
+		{
+			Name: "Always Throttled",
+			Responses: []testResourceManagerResponse{
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusTooManyRequests, body: `{}`},
+			},
+			ExpectedRequests: 3,
+			ExpectError:      true,
+		},
+		{
+			Name: "Always Unavailable",
+			Responses: []testResourceManagerResponse{
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusServiceUnavailable, body: `{}`},
+			},
+			ExpectedRequests: 3,
+			ExpectError:      true,
+		},
+		{
+			Name: "Resource Provider Not Registered",
+			Responses: []testResourceManagerResponse{
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusConflict, body: missingRegistration},
+				{method: http.MethodPost, path: providerPath + "/register", statusCode: http.StatusOK, body: `{"namespace": "Microsoft.Resources"}`},
+				{method: http.MethodGet, path: providerPath, statusCode: http.StatusOK, body: `{"namespace": "Microsoft.Resources", "registrationState": "Registered"}`},
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusOK, body: group},
+			},
+			ExpectedRequests: 4,
+		},
+		{
+			Name:     "Excluded Resource Provider Not Registered",
+			Excluded: []string{"Microsoft.Resources"},
+			Responses: []testResourceManagerResponse{
+				{method: http.MethodGet, path: groupPath, statusCode: http.StatusConflict, body: missingRegistration},
+			},
+			ExpectedRequests: 1,
+			ExpectError:      true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		requests := make([]string, 0)
+		server := testResourceManagerServer(t, v.Responses, &requests)
+
+		client := &ArmClient{
+			retryPolicy: azure.RetryPolicy{
+				MaxRetries: 2,
+				MaxBackoff: 0,
+			},
+		}
+
+		registrationClient := resources.NewProvidersClientWithBaseURI(server.URL, subscriptionId)
+		client.configureClient(&registrationClient.Client, autorest.NullAuthorizer{})
+		client.resourceProviderRegistrar = newResourceProviderRegistrar(registrationClient, v.Excluded, false)
+
+		groupsClient := resources.NewGroupsClientWithBaseURI(server.URL, subscriptionId)
+		client.configureClient(&groupsClient.Client, autorest.NullAuthorizer{})
+
+		// autorest's backoff is shortened such that any requests it retries are counted (rather than the test timing out)
+		groupsClient.RetryDuration = time.Millisecond
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		_, err := groupsClient.Get(ctx, "example-resources")
+		cancel()
+		server.Close()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if len(requests) != v.ExpectedRequests {
+			t.Fatalf("Expected %d requests to be sent but got %d", v.ExpectedRequests, len(requests))
+		}
+	}
+}
+
+type testStorageAuthorizer struct{}
+
+func (testStorageAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return autorest.WithHeader("Authorization", "Bearer example-token")
+}
+
+type testStorageRecordingSender struct {
+	request *http.Request
+}
+
+func (s *testStorageRecordingSender) Do(req *http.Request) (*http.Response, error) {
+	s.request = req
+	return &http.Response{StatusCode: http.StatusOK, Request: req}, nil
+}
+
+func TestStorageSenderAuthorization(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Authorizer autorest.Authorizer
+		Expected   string
+	}{
+		{
+			Name:     "Shared Key",
+			Expected: "SharedKey example:signature",
+		},
+		{
+			Name:       "Azure AD",
+			Authorizer: testStorageAuthorizer{},
+			Expected:   "Bearer example-token",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		req, err := http.NewRequest(http.MethodGet, "https://example.blob.core.windows.net/container", nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+		req.Header.Set("Authorization", "SharedKey example:signature")
+
+		recorder := &testStorageRecordingSender{}
+		sender := storageSender{
+			sender:     recorder,
+			authorizer: v.Authorizer,
+		}
+
+		if _, err := sender.Send(nil, req); err != nil {
+			t.Fatalf("Error sending request: %+v", err)
+		}
+
+		if actual := recorder.request.Header.Get("Authorization"); actual != v.Expected {
+			t.Fatalf("Expected the Authorization header to be %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+// testStorageSignatureVerifier returns a Sender which verifies the Shared Key signature of each request as it's
+// received, after the request has been through each of the decorators applied to the Sender
+func testStorageSignatureVerifier(t *testing.T, verifier *storageSharedKeyAuthorizer, statusCode int, requests *[]*http.Request) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		*requests = append(*requests, r)
+
+		expected := fmt.Sprintf("SharedKey %s:%s", verifier.accountName, verifier.sign(verifier.stringToSign(r)))
+		if actual := r.Header.Get("Authorization"); actual != expected {
+			t.Fatalf("Expected the signature to match the request as sent (%q) but got %q", expected, actual)
+		}
+
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+			Request:    r,
+		}, nil
+	})
+}
+
+func TestStorageSenderSharedKeySignature(t *testing.T) {
+	verifier, err := newStorageSharedKeyAuthorizer("example", "YXp1cmVhZA==")
+	if err != nil {
+		t.Fatalf("Error building the Authorizer: %+v", err)
+	}
+
+	storageClient, err := mainStorage.NewClient("example", "YXp1cmVhZA==", "core.windows.net", mainStorage.DefaultAPIVersion, true)
+	if err != nil {
+		t.Fatalf("Error building the Storage Client: %+v", err)
+	}
+
+	requests := make([]*http.Request, 0)
+	storageClient.Sender = storageSender{
+		sender: azure.DecorateSender(testStorageSignatureVerifier(t, verifier, http.StatusOK, &requests), azure.DefaultRetryPolicy(), nil),
+	}
+
+	blobClient := storageClient.GetBlobService()
+	exists, err := blobClient.GetContainerReference("container1").Exists()
+	if err != nil {
+		t.Fatalf("Error checking for the Container: %+v", err)
+	}
+
+	if !exists || len(requests) != 1 {
+		t.Fatalf("Expected a single request for a Container which exists but got %d requests", len(requests))
+	}
 }
@@ -0,0 +1,235 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+)
+
+func dataSourceArmResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourcesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[^/]+/[^/]+(/[^/]+)*$`),
+					"The Type must be in the format `{namespace}/{type}`, for example `Microsoft.Network/virtualNetworks`.",
+				),
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"name_prefix", "name_regex"},
+			},
+
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"name", "name_regex"},
+			},
+
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.ValidateRegexp,
+				ConflictsWith: []string{"name", "name_prefix"},
+			},
+
+			"required_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources()
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	resourceType := d.Get("type").(string)
+
+	// the Type is filtered in the API since this is the most selective filter - the others are only
+	// supported in limited combinations, so are applied below
+	filter := ""
+	if resourceType != "" {
+		// single quotes are escaped by doubling them up within OData string literals
+		filter = fmt.Sprintf("resourceType eq '%s'", strings.Replace(resourceType, "'", "''", -1))
+	}
+
+	var iterator resources.ListResultIterator
+	var err error
+	if resourceGroup != "" {
+		log.Printf("[DEBUG] Listing Resources in Resource Group %q (Filter %q)", resourceGroup, filter)
+		iterator, err = client.resourceGroupsClient.ListResourcesComplete(ctx, resourceGroup, filter, "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resources in Resource Group %q: %+v", resourceGroup, err)
+		}
+	} else {
+		log.Printf("[DEBUG] Listing Resources in the Subscription (Filter %q)", filter)
+		iterator, err = client.resourcesClient.ListComplete(ctx, filter, "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resources in the Subscription: %+v", err)
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		// validated in the schema
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	filters := resourcesFilter{
+		Type:         resourceType,
+		Name:         d.Get("name").(string),
+		NamePrefix:   d.Get("name_prefix").(string),
+		NameRegex:    nameRegex,
+		RequiredTags: expandTags(d.Get("required_tags").(map[string]interface{})),
+	}
+
+	results := make([]interface{}, 0)
+	for iterator.NotDone() {
+		resource := iterator.Value()
+		if filters.matches(resource) {
+			results = append(results, flattenDataSourceResource(resource))
+		}
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing Resources: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resources", results); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+// resourcesFilter determines which Resources are returned from the `azurerm_resources` Data Source
+type resourcesFilter struct {
+	Type         string
+	Name         string
+	NamePrefix   string
+	NameRegex    *regexp.Regexp
+	RequiredTags map[string]*string
+}
+
+func (f resourcesFilter) matches(resource resources.GenericResource) bool {
+	name := ""
+	if resource.Name != nil {
+		name = *resource.Name
+	}
+
+	if f.Type != "" && (resource.Type == nil || !strings.EqualFold(*resource.Type, f.Type)) {
+		return false
+	}
+
+	if f.Name != "" && name != f.Name {
+		return false
+	}
+
+	if f.NamePrefix != "" && !strings.HasPrefix(name, f.NamePrefix) {
+		return false
+	}
+
+	if f.NameRegex != nil && !f.NameRegex.MatchString(name) {
+		return false
+	}
+
+	for key, value := range f.RequiredTags {
+		actual, ok := resource.Tags[key]
+		if !ok || actual == nil || value == nil || *actual != *value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDataSourceResource(input resources.GenericResource) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	if input.Type != nil {
+		output["type"] = *input.Type
+	}
+
+	if input.Location != nil {
+		output["location"] = azureRMNormalizeLocation(*input.Location)
+	}
+
+	tags := make(map[string]interface{}, len(input.Tags))
+	for key, value := range input.Tags {
+		if value != nil {
+			tags[key] = *value
+		}
+	}
+	output["tags"] = tags
+
+	return output
+}
@@ -0,0 +1,251 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMResources_filters(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resourceConfig := testAccDataSourceAzureRMResources_template(ri, rs, location)
+	dataSourceConfig := testAccDataSourceAzureRMResources_filters(ri, rs, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_resources.type", "resources.#", "3"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.name", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.name", "resources.0.name", fmt.Sprintf("acctestpipa%s-0", rs)),
+					resource.TestCheckResourceAttr("data.azurerm_resources.name", "resources.0.type", "Microsoft.Network/publicIPAddresses"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.name", "resources.0.tags.%", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.name_prefix", "resources.#", "2"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.name_regex", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.name_regex", "resources.0.name", fmt.Sprintf("acctestpipb%s-0", rs)),
+					resource.TestCheckResourceAttr("data.azurerm_resources.required_tags", "resources.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.required_tags", "resources.0.tags.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResources_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  count                        = 2
+  name                         = "acctestpipa%s-${count.index}"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "test"
+  }
+}
+
+resource "azurerm_public_ip" "test2" {
+  name                         = "acctestpipb%s-0"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    environment = "production"
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rString, rString, rInt)
+}
+
+func testAccDataSourceAzureRMResources_filters(rInt int, rString string, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "type" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/publicIPAddresses"
+}
+
+data "azurerm_resources" "name" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  name                = "acctestpipa%s-0"
+}
+
+data "azurerm_resources" "name_prefix" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  name_prefix         = "acctestpipa"
+}
+
+data "azurerm_resources" "name_regex" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  name_regex          = "^acctestpipb.*-0$"
+}
+
+data "azurerm_resources" "required_tags" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/publicIPAddresses"
+
+  required_tags = {
+    environment = "production"
+  }
+}
+`, template, rString)
+}
+
+func TestResourcesFilterMatches(t *testing.T) {
+	input := resources.GenericResource{
+		Name: utils.String("example-vnet"),
+		Type: utils.String("Microsoft.Network/virtualNetworks"),
+		Tags: map[string]*string{
+			"environment": utils.String("production"),
+			"cost-center": utils.String("ops"),
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Filter   resourcesFilter
+		Expected bool
+	}{
+		{
+			Name:     "No Filters",
+			Filter:   resourcesFilter{},
+			Expected: true,
+		},
+		{
+			Name:     "Type Matches Case-Insensitively",
+			Filter:   resourcesFilter{Type: "microsoft.network/virtualnetworks"},
+			Expected: true,
+		},
+		{
+			Name:     "Type Differs",
+			Filter:   resourcesFilter{Type: "Microsoft.Network/publicIPAddresses"},
+			Expected: false,
+		},
+		{
+			Name:     "Name Matches",
+			Filter:   resourcesFilter{Name: "example-vnet"},
+			Expected: true,
+		},
+		{
+			Name:     "Name Differs",
+			Filter:   resourcesFilter{Name: "example"},
+			Expected: false,
+		},
+		{
+			Name:     "Name Differs in Case",
+			Filter:   resourcesFilter{Name: "Example-VNet"},
+			Expected: false,
+		},
+		{
+			Name:     "Name Prefix Matches",
+			Filter:   resourcesFilter{NamePrefix: "example-"},
+			Expected: true,
+		},
+		{
+			Name:     "Name Prefix Differs",
+			Filter:   resourcesFilter{NamePrefix: "vnet"},
+			Expected: false,
+		},
+		{
+			Name:     "Name Regex Matches",
+			Filter:   resourcesFilter{NameRegex: regexp.MustCompile("^example-[a-z]+$")},
+			Expected: true,
+		},
+		{
+			Name:     "Name Regex Differs",
+			Filter:   resourcesFilter{NameRegex: regexp.MustCompile("^[0-9]+$")},
+			Expected: false,
+		},
+		{
+			Name: "Required Tags Match",
+			Filter: resourcesFilter{
+				RequiredTags: map[string]*string{
+					"environment": utils.String("production"),
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Required Tag Value Differs",
+			Filter: resourcesFilter{
+				RequiredTags: map[string]*string{
+					"environment": utils.String("staging"),
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Required Tag Missing",
+			Filter: resourcesFilter{
+				RequiredTags: map[string]*string{
+					"environment": utils.String("production"),
+					"owner":       utils.String("networking"),
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "All Filters Match",
+			Filter: resourcesFilter{
+				Type:       "Microsoft.Network/virtualNetworks",
+				NamePrefix: "example",
+				NameRegex:  regexp.MustCompile("vnet$"),
+				RequiredTags: map[string]*string{
+					"cost-center": utils.String("ops"),
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "One Filter Differs",
+			Filter: resourcesFilter{
+				Type: "Microsoft.Network/virtualNetworks",
+				Name: "example-vnet",
+				RequiredTags: map[string]*string{
+					"cost-center": utils.String("finance"),
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := v.Filter.matches(input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package azure
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NewLazyAuthorizer returns an Authorizer which defers building the underlying Authorizer (which
+// typically involves acquiring a token) until the first request is authorized. Should this fail
+// the error is returned for that request - and building the Authorizer is retried on the next.
+func NewLazyAuthorizer(build func() (autorest.Authorizer, error)) autorest.Authorizer {
+	return &lazyAuthorizer{
+		build: build,
+	}
+}
+
+type lazyAuthorizer struct {
+	build      func() (autorest.Authorizer, error)
+	authorizer autorest.Authorizer
+	lock       sync.Mutex
+}
+
+func (a *lazyAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			authorizer, err := a.get()
+			if err != nil {
+				return r, err
+			}
+
+			return authorizer.WithAuthorization()(p).Prepare(r)
+		})
+	}
+}
+
+func (a *lazyAuthorizer) get() (autorest.Authorizer, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.authorizer != nil {
+		return a.authorizer, nil
+	}
+
+	authorizer, err := a.build()
+	if err != nil {
+		return nil, err
+	}
+
+	a.authorizer = authorizer
+	return authorizer, nil
+}
@@ -0,0 +1,71 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestLazyAuthorizer(t *testing.T) {
+	builds := 0
+	authorizer := NewLazyAuthorizer(func() (autorest.Authorizer, error) {
+		builds++
+		return autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+			"X-Example-Key": "example",
+		}), nil
+	})
+
+	if builds != 0 {
+		t.Fatalf("Expected the Authorizer not to be built until it's used but it was built %d times", builds)
+	}
+
+	for i := 0; i < 3; i++ {
+		req, err := autorest.Prepare(testRequest(t), authorizer.WithAuthorization())
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual := req.Header.Get("X-Example-Key"); actual != "example" {
+			t.Fatalf("Expected the `X-Example-Key` header to be %q but got %q", "example", actual)
+		}
+	}
+
+	if builds != 1 {
+		t.Fatalf("Expected the Authorizer to be built once but it was built %d times", builds)
+	}
+}
+
+func TestLazyAuthorizerError(t *testing.T) {
+	builds := 0
+	authorizer := NewLazyAuthorizer(func() (autorest.Authorizer, error) {
+		builds++
+		if builds == 1 {
+			return nil, fmt.Errorf("the principal has no access to this API")
+		}
+
+		return autorest.NullAuthorizer{}, nil
+	})
+
+	if _, err := autorest.Prepare(testRequest(t), authorizer.WithAuthorization()); err == nil {
+		t.Fatalf("Expected an error when the Authorizer couldn't be built but didn't get one")
+	}
+
+	if _, err := autorest.Prepare(testRequest(t), authorizer.WithAuthorization()); err != nil {
+		t.Fatalf("Expected the Authorizer to be rebuilt after an error but got: %+v", err)
+	}
+
+	if builds != 2 {
+		t.Fatalf("Expected the Authorizer to be built twice but it was built %d times", builds)
+	}
+}
+
+func testRequest(t *testing.T) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	return req
+}
@@ -0,0 +1,82 @@
+package azure
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ConcurrencyLimits controls how many requests can be in-flight at once, since reads and writes are throttled
+// separately by Resource Manager these have separate budgets. A limit of 0 means requests aren't limited.
+type ConcurrencyLimits struct {
+	// MaxReads is the number of concurrent read (GET/HEAD) requests
+	MaxReads int
+
+	// MaxWrites is the number of concurrent write (PUT/PATCH/POST/DELETE) requests
+	MaxWrites int
+}
+
+// RequestLimiter limits the number of concurrent requests made across all of the clients it's shared between,
+// and as such one RequestLimiter is used for every client within a Provider instance
+type RequestLimiter struct {
+	reads  chan struct{}
+	writes chan struct{}
+}
+
+// NewRequestLimiter returns a RequestLimiter which enforces the specified ConcurrencyLimits
+func NewRequestLimiter(limits ConcurrencyLimits) *RequestLimiter {
+	return &RequestLimiter{
+		reads:  newSemaphore(limits.MaxReads),
+		writes: newSemaphore(limits.MaxWrites),
+	}
+}
+
+func newSemaphore(size int) chan struct{} {
+	if size <= 0 {
+		return nil
+	}
+
+	return make(chan struct{}, size)
+}
+
+func (l *RequestLimiter) semaphoreFor(r *http.Request) chan struct{} {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return l.reads
+	default:
+		return l.writes
+	}
+}
+
+// withConcurrencyLimit returns a SendDecorator which waits for a slot in the read or write budget of the
+// RequestLimiter before sending each request, releasing it once the response has been received. Since this
+// is applied to each attempt, a throttled request doesn't hold on to its slot whilst waiting to be retried.
+func withConcurrencyLimit(limiter *RequestLimiter) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if limiter == nil {
+				return s.Do(r)
+			}
+
+			semaphore := limiter.semaphoreFor(r)
+			if semaphore == nil {
+				return s.Do(r)
+			}
+
+			select {
+			case semaphore <- struct{}{}:
+			default:
+				log.Printf("[DEBUG] Waiting for a concurrent request slot for %s %s", r.Method, redactURL(r.URL))
+				select {
+				case semaphore <- struct{}{}:
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+			defer func() { <-semaphore }()
+
+			return s.Do(r)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithConcurrencyLimit(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Limits            ConcurrencyLimits
+		ExpectedMaxReads  int
+		ExpectedMaxWrites int
+	}{
+		{
+			Name:              "Unlimited",
+			Limits:            ConcurrencyLimits{},
+			ExpectedMaxReads:  5,
+			ExpectedMaxWrites: 5,
+		},
+		{
+			Name: "Separate Budgets",
+			Limits: ConcurrencyLimits{
+				MaxReads:  2,
+				MaxWrites: 1,
+			},
+			ExpectedMaxReads:  2,
+			ExpectedMaxWrites: 1,
+		},
+		{
+			Name: "Only Writes Limited",
+			Limits: ConcurrencyLimits{
+				MaxWrites: 3,
+			},
+			ExpectedMaxReads:  5,
+			ExpectedMaxWrites: 3,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		lock := sync.Mutex{}
+		inFlight := map[string]int{}
+		maxInFlight := map[string]int{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			inFlight[r.Method]++
+			if inFlight[r.Method] > maxInFlight[r.Method] {
+				maxInFlight[r.Method] = inFlight[r.Method]
+			}
+			lock.Unlock()
+
+			time.Sleep(50 * time.Millisecond)
+
+			lock.Lock()
+			inFlight[r.Method]--
+			lock.Unlock()
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		sender := autorest.DecorateSender(server.Client(), withConcurrencyLimit(NewRequestLimiter(v.Limits)))
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 5; i++ {
+			for _, method := range []string{http.MethodGet, http.MethodPut} {
+				wg.Add(1)
+				go func(method string) {
+					defer wg.Done()
+
+					req, err := http.NewRequest(method, server.URL, nil)
+					if err != nil {
+						t.Errorf("Error building request: %+v", err)
+						return
+					}
+
+					resp, err := sender.Do(req)
+					if err != nil {
+						t.Errorf("Expected no error but got: %+v", err)
+						return
+					}
+					resp.Body.Close()
+				}(method)
+			}
+		}
+		wg.Wait()
+		server.Close()
+
+		if maxInFlight[http.MethodGet] > v.ExpectedMaxReads {
+			t.Fatalf("Expected at most %d concurrent reads but got %d", v.ExpectedMaxReads, maxInFlight[http.MethodGet])
+		}
+
+		if maxInFlight[http.MethodPut] > v.ExpectedMaxWrites {
+			t.Fatalf("Expected at most %d concurrent writes but got %d", v.ExpectedMaxWrites, maxInFlight[http.MethodPut])
+		}
+	}
+}
+
+func TestWithConcurrencyLimitCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := NewRequestLimiter(ConcurrencyLimits{
+		MaxWrites: 1,
+	})
+
+	// occupy the only write slot, so the request has to wait
+	limiter.writes <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodDelete, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(server.Client(), withConcurrencyLimit(limiter))
+	if _, err := sender.Do(req.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the error to be %+v but got %+v", context.DeadlineExceeded, err)
+	}
+}
@@ -0,0 +1,267 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+const (
+	// metadataApiVersion is the version of the `/metadata/endpoints` API which includes the DNS Suffixes for
+	// each service, which is available in the Public & Sovereign Clouds (and recent versions of Azure Stack)
+	metadataApiVersion = "2019-05-01"
+
+	// legacyMetadataApiVersion is the version of the `/metadata/endpoints` API supported by all versions of
+	// Azure Stack, which doesn't include any DNS Suffixes - as such these are derived from the Metadata Host
+	legacyMetadataApiVersion = "1.0"
+)
+
+// Environment is an Azure Environment (Cloud) which isn't built into go-autorest, along with the DNS Suffixes for
+// services which aren't part of the `azure.Environment` - such as Azure Stack or an isolated Sovereign Cloud
+type Environment struct {
+	az.Environment
+
+	// DataLakeStoreDNSSuffix is the DNS Suffix for the Data Lake Store File System, e.g. `azuredatalakestore.net`
+	DataLakeStoreDNSSuffix string `json:"dataLakeStoreDNSSuffix"`
+}
+
+// LoadEnvironmentFromFile loads an Environment from a JSON file on disk, which uses the same
+// schema as the `azure.Environment` struct in go-autorest (e.g. `resourceManagerEndpoint`)
+func LoadEnvironmentFromFile(path string) (*Environment, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Environment File %q: %+v", path, err)
+	}
+
+	var env Environment
+	if err := json.Unmarshal(contents, &env); err != nil {
+		return nil, fmt.Errorf("Error parsing Environment File %q: %+v", path, err)
+	}
+
+	if env.Name == "" {
+		env.Name = "CustomEnvironment"
+	}
+
+	if err := validateEnvironment(env); err != nil {
+		return nil, fmt.Errorf("Error validating Environment File %q: %+v", path, err)
+	}
+
+	return &env, nil
+}
+
+// LoadEnvironmentFromMetadataHost loads an Environment from the `/metadata/endpoints` API exposed
+// by Resource Manager on the specified host (e.g. `management.local.azurestack.external`)
+func LoadEnvironmentFromMetadataHost(ctx context.Context, sender autorest.Sender, host string) (*Environment, error) {
+	endpoint := host
+	if !strings.Contains(endpoint, "://") {
+		endpoint = fmt.Sprintf("https://%s", endpoint)
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	metadataUrl, err := url.Parse(endpoint)
+	if err != nil || metadataUrl.Host == "" {
+		return nil, fmt.Errorf("Error parsing Metadata Host %q: expected a hostname such as `management.azure.com`", host)
+	}
+
+	env, err := loadEnvironmentFromMetadata(ctx, sender, endpoint, metadataUrl.Hostname())
+	if err != nil {
+		return nil, fmt.Errorf("Error loading Environment from Metadata Host %q: %+v", host, err)
+	}
+
+	if err := validateEnvironment(*env); err != nil {
+		return nil, fmt.Errorf("Error validating Environment from Metadata Host %q: %+v", host, err)
+	}
+
+	return env, nil
+}
+
+type metadataAuthentication struct {
+	LoginEndpoint string   `json:"loginEndpoint"`
+	Audiences     []string `json:"audiences"`
+}
+
+type metadataSuffixes struct {
+	AcrLoginServer               string `json:"acrLoginServer"`
+	AzureDataLakeStoreFileSystem string `json:"azureDataLakeStoreFileSystem"`
+	KeyVaultDns                  string `json:"keyVaultDns"`
+	SqlServerHostname            string `json:"sqlServerHostname"`
+	Storage                      string `json:"storage"`
+}
+
+// metadataEnvironment is an Environment returned from the `/metadata/endpoints` API
+type metadataEnvironment struct {
+	Name            string                 `json:"name"`
+	Authentication  metadataAuthentication `json:"authentication"`
+	Batch           string                 `json:"batch"`
+	Gallery         string                 `json:"gallery"`
+	GalleryEndpoint string                 `json:"galleryEndpoint"`
+	Graph           string                 `json:"graph"`
+	GraphEndpoint   string                 `json:"graphEndpoint"`
+	Portal          string                 `json:"portal"`
+	PortalEndpoint  string                 `json:"portalEndpoint"`
+	ResourceManager string                 `json:"resourceManager"`
+	Suffixes        metadataSuffixes       `json:"suffixes"`
+}
+
+func loadEnvironmentFromMetadata(ctx context.Context, sender autorest.Sender, endpoint string, hostname string) (*Environment, error) {
+	statusCode, body, err := getMetadata(ctx, sender, endpoint, metadataApiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode == http.StatusOK {
+		var environments []metadataEnvironment
+		if err := json.Unmarshal(body, &environments); err != nil {
+			return nil, fmt.Errorf("Error parsing Metadata: %+v", err)
+		}
+
+		for _, env := range environments {
+			if resourceManagerUrl, err := url.Parse(env.ResourceManager); err == nil && strings.EqualFold(resourceManagerUrl.Hostname(), hostname) {
+				return buildEnvironmentFromMetadata(env, endpoint), nil
+			}
+		}
+
+		if len(environments) == 1 {
+			return buildEnvironmentFromMetadata(environments[0], endpoint), nil
+		}
+
+		return nil, fmt.Errorf("No Environment was found for the Resource Manager endpoint %q", endpoint)
+	}
+
+	// older versions of Azure Stack only support the legacy API version
+	statusCode, body, err = getMetadata(ctx, sender, endpoint, legacyMetadataApiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected Status Code %d retrieving Metadata", statusCode)
+	}
+
+	var env metadataEnvironment
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, fmt.Errorf("Error parsing Metadata: %+v", err)
+	}
+
+	// the legacy API doesn't return the DNS Suffixes, which (as in Azure Stack) are relative to the Resource Manager
+	// host: for example the Storage DNS Suffix for `management.local.azurestack.external` is `local.azurestack.external`
+	stampDNSSuffix := hostname
+	if i := strings.Index(hostname, "."); i > 0 {
+		stampDNSSuffix = hostname[i+1:]
+	}
+
+	env.Name = "AzureStack"
+	env.ResourceManager = endpoint
+	env.Suffixes = metadataSuffixes{
+		KeyVaultDns: fmt.Sprintf("vault.%s", stampDNSSuffix),
+		Storage:     stampDNSSuffix,
+	}
+	return buildEnvironmentFromMetadata(env, endpoint), nil
+}
+
+func getMetadata(ctx context.Context, sender autorest.Sender, endpoint string, apiVersion string) (int, []byte, error) {
+	uri := fmt.Sprintf("%s/metadata/endpoints?api-version=%s", endpoint, apiVersion)
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error building Metadata request: %+v", err)
+	}
+
+	resp, err := sender.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error retrieving Metadata: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error reading Metadata: %+v", err)
+	}
+
+	return resp.StatusCode, body, nil
+}
+
+func buildEnvironmentFromMetadata(input metadataEnvironment, endpoint string) *Environment {
+	resourceManagerEndpoint := input.ResourceManager
+	if resourceManagerEndpoint == "" {
+		resourceManagerEndpoint = endpoint
+	}
+	resourceManagerEndpoint = ensureTrailingSlash(resourceManagerEndpoint)
+
+	// prefer the Audience which matches the Resource Manager endpoint, since some Clouds return multiple
+	tokenAudience := ""
+	for _, audience := range input.Authentication.Audiences {
+		if tokenAudience == "" || strings.EqualFold(ensureTrailingSlash(audience), resourceManagerEndpoint) {
+			tokenAudience = audience
+		}
+	}
+
+	env := Environment{
+		Environment: az.Environment{
+			Name:                       input.Name,
+			ManagementPortalURL:        firstNonEmpty(input.Portal, input.PortalEndpoint),
+			ServiceManagementEndpoint:  tokenAudience,
+			ResourceManagerEndpoint:    resourceManagerEndpoint,
+			ActiveDirectoryEndpoint:    ensureTrailingSlash(input.Authentication.LoginEndpoint),
+			GalleryEndpoint:            firstNonEmpty(input.Gallery, input.GalleryEndpoint),
+			GraphEndpoint:              firstNonEmpty(input.Graph, input.GraphEndpoint),
+			BatchManagementEndpoint:    input.Batch,
+			StorageEndpointSuffix:      input.Suffixes.Storage,
+			SQLDatabaseDNSSuffix:       strings.TrimPrefix(input.Suffixes.SqlServerHostname, "."),
+			KeyVaultDNSSuffix:          input.Suffixes.KeyVaultDns,
+			ContainerRegistryDNSSuffix: input.Suffixes.AcrLoginServer,
+			TokenAudience:              tokenAudience,
+		},
+		DataLakeStoreDNSSuffix: input.Suffixes.AzureDataLakeStoreFileSystem,
+	}
+
+	if env.KeyVaultDNSSuffix != "" {
+		env.KeyVaultEndpoint = fmt.Sprintf("https://%s/", env.KeyVaultDNSSuffix)
+	}
+
+	return &env
+}
+
+func validateEnvironment(env Environment) error {
+	if env.ResourceManagerEndpoint == "" {
+		return fmt.Errorf("a Resource Manager Endpoint must be specified")
+	}
+
+	if env.ActiveDirectoryEndpoint == "" {
+		return fmt.Errorf("an Active Directory Endpoint must be specified")
+	}
+
+	if env.TokenAudience == "" {
+		return fmt.Errorf("a Token Audience must be specified")
+	}
+
+	if env.StorageEndpointSuffix == "" {
+		return fmt.Errorf("a Storage Endpoint Suffix must be specified")
+	}
+
+	return nil
+}
+
+func ensureTrailingSlash(input string) string {
+	if input == "" || strings.HasSuffix(input, "/") {
+		return input
+	}
+
+	return input + "/"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
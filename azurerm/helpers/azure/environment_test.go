@@ -0,0 +1,247 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoadEnvironmentFromMetadataHost(t *testing.T) {
+	testCases := []struct {
+		Name                            string
+		Responses                       map[string]string
+		ExpectedName                    string
+		ExpectedActiveDirectoryEndpoint string
+		ExpectedGraphEndpoint           string
+		ExpectedTokenAudience           string
+		ExpectedStorageEndpointSuffix   string
+		ExpectedKeyVaultDNSSuffix       string
+		ExpectedDataLakeStoreDNSSuffix  string
+		Error                           bool
+	}{
+		{
+			Name: "Metadata",
+			Responses: map[string]string{
+				metadataApiVersion: `[
+  {
+    "name": "ExampleCloud",
+    "portal": "https://portal.example.cloud",
+    "authentication": {
+      "loginEndpoint": "https://login.example.cloud",
+      "audiences": ["https://management.core.example.cloud/", "{{resourceManager}}"]
+    },
+    "graph": "https://graph.example.cloud/",
+    "resourceManager": "{{resourceManager}}",
+    "suffixes": {
+      "azureDataLakeStoreFileSystem": "datalakestore.example.cloud",
+      "keyVaultDns": "vault.example.cloud",
+      "sqlServerHostname": ".database.example.cloud",
+      "storage": "core.example.cloud"
+    }
+  }
+]`,
+			},
+			ExpectedName:                    "ExampleCloud",
+			ExpectedActiveDirectoryEndpoint: "https://login.example.cloud/",
+			ExpectedGraphEndpoint:           "https://graph.example.cloud/",
+			ExpectedTokenAudience:           "{{resourceManager}}",
+			ExpectedStorageEndpointSuffix:   "core.example.cloud",
+			ExpectedKeyVaultDNSSuffix:       "vault.example.cloud",
+			ExpectedDataLakeStoreDNSSuffix:  "datalakestore.example.cloud",
+		},
+		{
+			Name: "Metadata for another Cloud",
+			Responses: map[string]string{
+				metadataApiVersion: `[
+  {
+    "name": "FirstCloud",
+    "authentication": {"loginEndpoint": "https://login.first.cloud/", "audiences": ["https://management.first.cloud/"]},
+    "resourceManager": "https://management.first.cloud/",
+    "suffixes": {"storage": "core.first.cloud"}
+  },
+  {
+    "name": "SecondCloud",
+    "authentication": {"loginEndpoint": "https://login.second.cloud/", "audiences": ["https://management.second.cloud/"]},
+    "resourceManager": "https://management.second.cloud/",
+    "suffixes": {"storage": "core.second.cloud"}
+  }
+]`,
+			},
+			Error: true,
+		},
+		{
+			Name: "Legacy Metadata",
+			Responses: map[string]string{
+				legacyMetadataApiVersion: `{
+  "galleryEndpoint": "https://portal.local.azurestack.external:30015/",
+  "graphEndpoint": "https://graph.windows.net/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.windows.net/",
+    "audiences": ["https://management.azurestack.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000"]
+  }
+}`,
+			},
+			ExpectedName:                    "AzureStack",
+			ExpectedActiveDirectoryEndpoint: "https://login.windows.net/",
+			ExpectedGraphEndpoint:           "https://graph.windows.net/",
+			ExpectedTokenAudience:           "https://management.azurestack.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000",
+			// the test server listens on 127.0.0.1, so the suffix is the host without the first label
+			ExpectedStorageEndpointSuffix: "0.0.1",
+			ExpectedKeyVaultDNSSuffix:     "vault.0.0.1",
+		},
+		{
+			Name: "Missing Audience",
+			Responses: map[string]string{
+				metadataApiVersion: `[
+  {
+    "name": "ExampleCloud",
+    "authentication": {"loginEndpoint": "https://login.example.cloud/", "audiences": []},
+    "resourceManager": "{{resourceManager}}",
+    "suffixes": {"storage": "core.example.cloud"}
+  }
+]`,
+			},
+			Error: true,
+		},
+		{
+			Name:      "Not Found",
+			Responses: map[string]string{},
+			Error:     true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/metadata/endpoints" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			body, ok := v.Responses[r.URL.Query().Get("api-version")]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, strings.Replace(body, "{{resourceManager}}", server.URL+"/", -1))
+		}))
+
+		env, err := LoadEnvironmentFromMetadataHost(context.Background(), server.Client(), server.URL)
+		server.Close()
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		expectedTokenAudience := strings.Replace(v.ExpectedTokenAudience, "{{resourceManager}}", server.URL+"/", -1)
+		checks := map[string][]string{
+			"Name":                    {v.ExpectedName, env.Name},
+			"ResourceManagerEndpoint": {server.URL + "/", env.ResourceManagerEndpoint},
+			"ActiveDirectoryEndpoint": {v.ExpectedActiveDirectoryEndpoint, env.ActiveDirectoryEndpoint},
+			"GraphEndpoint":           {v.ExpectedGraphEndpoint, env.GraphEndpoint},
+			"TokenAudience":           {expectedTokenAudience, env.TokenAudience},
+			"StorageEndpointSuffix":   {v.ExpectedStorageEndpointSuffix, env.StorageEndpointSuffix},
+			"KeyVaultDNSSuffix":       {v.ExpectedKeyVaultDNSSuffix, env.KeyVaultDNSSuffix},
+			"DataLakeStoreDNSSuffix":  {v.ExpectedDataLakeStoreDNSSuffix, env.DataLakeStoreDNSSuffix},
+		}
+		for field, values := range checks {
+			if values[0] != values[1] {
+				t.Fatalf("Expected %s to be %q but got %q", field, values[0], values[1])
+			}
+		}
+	}
+}
+
+func TestLoadEnvironmentFromFile(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Contents string
+		Error    bool
+	}{
+		{
+			Name: "Valid",
+			Contents: `{
+  "name": "ExampleCloud",
+  "resourceManagerEndpoint": "https://management.example.cloud/",
+  "activeDirectoryEndpoint": "https://login.example.cloud/",
+  "graphEndpoint": "https://graph.example.cloud/",
+  "tokenAudience": "https://management.example.cloud/",
+  "storageEndpointSuffix": "core.example.cloud",
+  "keyVaultDNSSuffix": "vault.example.cloud",
+  "dataLakeStoreDNSSuffix": "datalakestore.example.cloud"
+}`,
+		},
+		{
+			Name: "Missing Resource Manager Endpoint",
+			Contents: `{
+  "activeDirectoryEndpoint": "https://login.example.cloud/",
+  "tokenAudience": "https://management.example.cloud/",
+  "storageEndpointSuffix": "core.example.cloud"
+}`,
+			Error: true,
+		},
+		{
+			Name:     "Invalid JSON",
+			Contents: `{"name": `,
+			Error:    true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		file, err := ioutil.TempFile("", "tf-environment")
+		if err != nil {
+			t.Fatalf("Error creating temporary file: %+v", err)
+		}
+		file.WriteString(v.Contents)
+		file.Close()
+
+		env, err := LoadEnvironmentFromFile(file.Name())
+		os.Remove(file.Name())
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if env.Name != "ExampleCloud" {
+			t.Fatalf("Expected the Name to be %q but got %q", "ExampleCloud", env.Name)
+		}
+
+		if env.StorageEndpointSuffix != "core.example.cloud" {
+			t.Fatalf("Expected the Storage Endpoint Suffix to be %q but got %q", "core.example.cloud", env.StorageEndpointSuffix)
+		}
+
+		if env.DataLakeStoreDNSSuffix != "datalakestore.example.cloud" {
+			t.Fatalf("Expected the Data Lake Store DNS Suffix to be %q but got %q", "datalakestore.example.cloud", env.DataLakeStoreDNSSuffix)
+		}
+	}
+
+	if _, err := LoadEnvironmentFromFile("does-not-exist.json"); err == nil {
+		t.Fatalf("Expected an error when the Environment File doesn't exist but didn't get one")
+	}
+}
@@ -0,0 +1,291 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ArmError is an error returned from Resource Manager (or another Azure API), decoded from either the ARM
+// error envelope (`{"error": {"code": .., "message": .., "details": [..]}}`) or the OData error envelope
+type ArmError struct {
+	Code    string
+	Message string
+	Target  string
+	Details []ArmError
+
+	// PolicyAssignments are the names of the Policy Assignments which disallowed the request, if any
+	PolicyAssignments []string
+}
+
+var (
+	resourceProviderNamespaceRegex = regexp.MustCompile(`(?i)namespace '([^']+)'`)
+	policyAssignmentNameRegex      = regexp.MustCompile(`"policyAssignment"\s*:\s*\{\s*"name"\s*:\s*"([^"]+)"`)
+)
+
+// armErrorHints are the hints displayed for well-known error codes, keyed by the lower-cased code
+var armErrorHints = map[string]func(e ArmError) string{
+	"missingsubscriptionregistration": resourceProviderHint,
+	"noregisteredproviderfound":       resourceProviderHint,
+	"subscriptionnotregistered":       resourceProviderHint,
+	"requestdisallowedbypolicy":       policyHint,
+	"quotaexceeded":                   quotaHint,
+	"resourcequotaexceeded":           quotaHint,
+	"operationnotallowed": func(e ArmError) string {
+		// Compute returns `OperationNotAllowed` when the requested cores would exceed the quota
+		if strings.Contains(strings.ToLower(e.Message), "quota") {
+			return quotaHint(e)
+		}
+		return ""
+	},
+	"skunotavailable":                     skuHint,
+	"skunotsupported":                     skuHint,
+	"locationnotavailableforresourcetype": skuHint,
+}
+
+// DecodeArmError decodes the ARM or OData error envelope in the specified response body, including the
+// body of a failed long-running operation (`{"status": "Failed", "error": {..}}`)
+func DecodeArmError(body []byte) (*ArmError, bool) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '{' {
+		return nil, false
+	}
+
+	var envelope struct {
+		Error      *armErrorBody `json:"error"`
+		OdataError *armErrorBody `json:"odata.error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, false
+	}
+
+	errorBody := envelope.Error
+	if errorBody == nil {
+		errorBody = envelope.OdataError
+	}
+	if errorBody == nil {
+		// some APIs return the error without the envelope
+		var bare armErrorBody
+		if err := json.Unmarshal(body, &bare); err != nil {
+			return nil, false
+		}
+		errorBody = &bare
+	}
+
+	if errorBody.Code == "" {
+		return nil, false
+	}
+
+	armErr := errorBody.toArmError()
+	return &armErr, true
+}
+
+// ArmErrorFromError attempts to decode an ArmError from an error returned from the Azure SDK
+func ArmErrorFromError(err error) (*ArmError, bool) {
+	switch e := err.(type) {
+	case *az.ServiceError:
+		return armErrorFromServiceError(e)
+	case az.ServiceError:
+		return armErrorFromServiceError(&e)
+	case *az.RequestError:
+		return armErrorFromServiceError(e.ServiceError)
+	case az.RequestError:
+		return armErrorFromServiceError(e.ServiceError)
+	case autorest.DetailedError:
+		if armErr, ok := DecodeArmError(e.ServiceError); ok {
+			return armErr, true
+		}
+		if e.Original != nil {
+			return ArmErrorFromError(e.Original)
+		}
+	case *autorest.DetailedError:
+		return ArmErrorFromError(*e)
+	}
+
+	return nil, false
+}
+
+// FormatError returns a concise message for the specified error, decoding any ARM error
+// (and providing hints for well-known errors) - or the error message if it's not an ARM error
+func FormatError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if armErr, ok := ArmErrorFromError(err); ok {
+		return armErr.String()
+	}
+
+	return err.Error()
+}
+
+// String returns a concise multi-line message for this error, including any nested details and hints
+func (e ArmError) String() string {
+	lines := make([]string, 0)
+	e.format(&lines, "")
+	return strings.Join(lines, "\n")
+}
+
+func (e ArmError) format(lines *[]string, indent string) {
+	line := indent + e.Code
+	if e.Target != "" {
+		line += fmt.Sprintf(" (Target %q)", e.Target)
+	}
+	if message := strings.TrimSpace(e.Message); message != "" {
+		line += ": " + message
+	}
+	*lines = append(*lines, line)
+
+	if hint := e.hint(); hint != "" {
+		*lines = append(*lines, fmt.Sprintf("%s  Hint: %s", indent, hint))
+	}
+
+	for _, detail := range e.Details {
+		detail.format(lines, indent+"  ")
+	}
+}
+
+func (e ArmError) hint() string {
+	if f, ok := armErrorHints[strings.ToLower(e.Code)]; ok {
+		return f(e)
+	}
+
+	return ""
+}
+
+func resourceProviderHint(e ArmError) string {
+	match := resourceProviderNamespaceRegex.FindStringSubmatch(e.Message)
+	if len(match) != 2 {
+		return "the Resource Provider isn't registered in this Subscription - either add it to `additional_resource_providers` in the Provider block (and remove `skip_provider_registration`, if set) or register it using `az provider register --namespace <namespace>`"
+	}
+
+	namespace := match[1]
+	return fmt.Sprintf("the Resource Provider %q isn't registered in this Subscription - either add %q to `additional_resource_providers` in the Provider block (and remove `skip_provider_registration`, if set) or register it using `az provider register --namespace %s`", namespace, namespace, namespace)
+}
+
+func policyHint(e ArmError) string {
+	if len(e.PolicyAssignments) == 0 {
+		return "this request was denied by a Policy Assignment in this Subscription - review the Policy Assignments using `az policy assignment list`"
+	}
+
+	return fmt.Sprintf("this request was denied by the Policy Assignment(s) %s - either update the configuration to comply with the Policy or request an exemption", strings.Join(quote(e.PolicyAssignments), ", "))
+}
+
+func quotaHint(_ ArmError) string {
+	return "a quota for this Subscription/Region would be exceeded - request a quota increase from the Azure Portal (Help + support) or use a different Region or SKU"
+}
+
+func skuHint(_ ArmError) string {
+	return "the requested SKU/Size isn't available in this Region for this Subscription - check the SKUs which are available using `az vm list-skus --location <location>` (or the equivalent for this service)"
+}
+
+func quote(input []string) []string {
+	output := make([]string, 0, len(input))
+	for _, v := range input {
+		output = append(output, fmt.Sprintf("%q", v))
+	}
+	return output
+}
+
+// armErrorBody is the error within an ARM or OData error envelope
+type armErrorBody struct {
+	Code           string              `json:"code"`
+	Message        json.RawMessage     `json:"message"`
+	Target         string              `json:"target"`
+	Details        []armErrorBody      `json:"details"`
+	AdditionalInfo []armAdditionalInfo `json:"additionalInfo"`
+}
+
+type armAdditionalInfo struct {
+	Type string `json:"type"`
+	Info struct {
+		PolicyAssignmentName        string `json:"policyAssignmentName"`
+		PolicyAssignmentDisplayName string `json:"policyAssignmentDisplayName"`
+	} `json:"info"`
+}
+
+func (b armErrorBody) toArmError() ArmError {
+	message := decodeArmErrorMessage(b.Message)
+	output := ArmError{
+		Code:              b.Code,
+		Message:           message,
+		Target:            b.Target,
+		Details:           make([]ArmError, 0),
+		PolicyAssignments: make([]string, 0),
+	}
+
+	// the message for a failed deployment operation is frequently the (JSON) response from the Resource Provider
+	if nested, ok := DecodeArmError([]byte(message)); ok {
+		output.Message = ""
+		output.Details = append(output.Details, *nested)
+	}
+
+	for _, info := range b.AdditionalInfo {
+		if !strings.EqualFold(info.Type, "PolicyViolation") {
+			continue
+		}
+
+		name := info.Info.PolicyAssignmentDisplayName
+		if name == "" {
+			name = info.Info.PolicyAssignmentName
+		}
+		if name != "" {
+			output.PolicyAssignments = append(output.PolicyAssignments, name)
+		}
+	}
+
+	// older API versions only include the Policy Assignment in the message
+	if len(output.PolicyAssignments) == 0 && strings.EqualFold(b.Code, "RequestDisallowedByPolicy") {
+		for _, match := range policyAssignmentNameRegex.FindAllStringSubmatch(message, -1) {
+			output.PolicyAssignments = append(output.PolicyAssignments, match[1])
+		}
+	}
+
+	for _, detail := range b.Details {
+		output.Details = append(output.Details, detail.toArmError())
+	}
+
+	return output
+}
+
+// decodeArmErrorMessage decodes the message, which is a string in the ARM envelope but
+// can be an object containing the value (`{"lang": "en", "value": ".."}`) in the OData envelope
+func decodeArmErrorMessage(input json.RawMessage) string {
+	if len(input) == 0 {
+		return ""
+	}
+
+	var message string
+	if err := json.Unmarshal(input, &message); err == nil {
+		return message
+	}
+
+	var odataMessage struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(input, &odataMessage); err == nil {
+		return odataMessage.Value
+	}
+
+	return string(input)
+}
+
+func armErrorFromServiceError(serviceError *az.ServiceError) (*ArmError, bool) {
+	if serviceError == nil || serviceError.Code == "" {
+		return nil, false
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"error": serviceError,
+	})
+	if err != nil {
+		return nil, false
+	}
+
+	return DecodeArmError(body)
+}
@@ -0,0 +1,200 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestDecodeArmError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Body     string
+		Expected string
+		Decoded  bool
+	}{
+		{
+			Name:    "Empty",
+			Body:    "",
+			Decoded: false,
+		},
+		{
+			Name:    "Not an Error",
+			Body:    `{"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", "name": "example"}`,
+			Decoded: false,
+		},
+		{
+			Name:     "ARM Error",
+			Body:     `{"error": {"code": "ResourceGroupNotFound", "message": "Resource group 'example' could not be found."}}`,
+			Expected: "ResourceGroupNotFound: Resource group 'example' could not be found.",
+			Decoded:  true,
+		},
+		{
+			Name:     "Error without an Envelope",
+			Body:     `{"code": "BadRequest", "message": "The value of ` + "`name`" + ` is invalid.", "target": "name"}`,
+			Expected: "BadRequest (Target \"name\"): The value of `name` is invalid.",
+			Decoded:  true,
+		},
+		{
+			Name:     "OData Error",
+			Body:     `{"odata.error": {"code": "Authorization_RequestDenied", "message": {"lang": "en", "value": "Insufficient privileges to complete the operation."}}}`,
+			Expected: "Authorization_RequestDenied: Insufficient privileges to complete the operation.",
+			Decoded:  true,
+		},
+		{
+			Name:     "Failed Long Running Operation",
+			Body:     `{"status": "Failed", "error": {"code": "InternalServerError", "message": "An unexpected error occurred while processing the request."}}`,
+			Expected: "InternalServerError: An unexpected error occurred while processing the request.",
+			Decoded:  true,
+		},
+		{
+			Name: "Policy Violation in a Template Deployment",
+			Body: `{
+  "error": {
+    "code": "InvalidTemplateDeployment",
+    "message": "The template deployment failed because of policy violation. Please see details for more information.",
+    "details": [
+      {
+        "code": "RequestDisallowedByPolicy",
+        "target": "examplestorageaccount",
+        "message": "Resource 'examplestorageaccount' was disallowed by policy.",
+        "additionalInfo": [
+          {
+            "type": "PolicyViolation",
+            "info": {
+              "policyDefinitionDisplayName": "Allowed locations",
+              "policyAssignmentName": "00000000000000000000000000000000",
+              "policyAssignmentDisplayName": "Allowed locations"
+            }
+          }
+        ]
+      }
+    ]
+  }
+}`,
+			Expected: `InvalidTemplateDeployment: The template deployment failed because of policy violation. Please see details for more information.
+  RequestDisallowedByPolicy (Target "examplestorageaccount"): Resource 'examplestorageaccount' was disallowed by policy.
+    Hint: this request was denied by the Policy Assignment(s) "Allowed locations" - either update the configuration to comply with the Policy or request an exemption`,
+			Decoded: true,
+		},
+		{
+			Name:     "Policy Violation in the Message",
+			Body:     `{"error": {"code": "RequestDisallowedByPolicy", "message": "Resource 'example' was disallowed by policy. Policy identifiers: '[{\"policyAssignment\":{\"name\":\"Require Tags\",\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/require-tags\"}}]'."}}`,
+			Expected: "RequestDisallowedByPolicy: Resource 'example' was disallowed by policy. Policy identifiers: '[{\"policyAssignment\":{\"name\":\"Require Tags\",\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/require-tags\"}}]'.\n  Hint: this request was denied by the Policy Assignment(s) \"Require Tags\" - either update the configuration to comply with the Policy or request an exemption",
+			Decoded:  true,
+		},
+		{
+			Name: "Failed Deployment with a nested Error",
+			Body: `{
+  "status": "Failed",
+  "error": {
+    "code": "DeploymentFailed",
+    "message": "At least one resource deployment operation failed. Please list deployment operations for details.",
+    "details": [
+      {
+        "code": "BadRequest",
+        "message": "{\r\n  \"error\": {\r\n    \"code\": \"SkuNotAvailable\",\r\n    \"message\": \"The requested size for resource 'example' is currently not available in location 'westeurope'.\"\r\n  }\r\n}"
+      }
+    ]
+  }
+}`,
+			Expected: `DeploymentFailed: At least one resource deployment operation failed. Please list deployment operations for details.
+  BadRequest
+    SkuNotAvailable: The requested size for resource 'example' is currently not available in location 'westeurope'.
+      Hint: the requested SKU/Size isn't available in this Region for this Subscription - check the SKUs which are available using ` + "`az vm list-skus --location <location>`" + ` (or the equivalent for this service)`,
+			Decoded: true,
+		},
+		{
+			Name:     "Unregistered Resource Provider",
+			Body:     `{"error": {"code": "MissingSubscriptionRegistration", "message": "The subscription is not registered to use namespace 'Microsoft.Databricks'."}}`,
+			Expected: "MissingSubscriptionRegistration: The subscription is not registered to use namespace 'Microsoft.Databricks'.\n  Hint: the Resource Provider \"Microsoft.Databricks\" isn't registered in this Subscription - either add \"Microsoft.Databricks\" to `additional_resource_providers` in the Provider block (and remove `skip_provider_registration`, if set) or register it using `az provider register --namespace Microsoft.Databricks`",
+			Decoded:  true,
+		},
+		{
+			Name:     "Quota Exceeded",
+			Body:     `{"error": {"code": "OperationNotAllowed", "message": "Operation could not be completed as it results in exceeding approved Total Regional Cores quota."}}`,
+			Expected: "OperationNotAllowed: Operation could not be completed as it results in exceeding approved Total Regional Cores quota.\n  Hint: a quota for this Subscription/Region would be exceeded - request a quota increase from the Azure Portal (Help + support) or use a different Region or SKU",
+			Decoded:  true,
+		},
+		{
+			Name:     "Operation Not Allowed",
+			Body:     `{"error": {"code": "OperationNotAllowed", "message": "The operation is not allowed whilst the resource is being updated."}}`,
+			Expected: "OperationNotAllowed: The operation is not allowed whilst the resource is being updated.",
+			Decoded:  true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		armErr, decoded := DecodeArmError([]byte(v.Body))
+		if decoded != v.Decoded {
+			t.Fatalf("Expected the error to be decoded to be %t but got %t", v.Decoded, decoded)
+		}
+
+		if !decoded {
+			continue
+		}
+
+		if actual := armErr.String(); actual != v.Expected {
+			t.Fatalf("Expected the message to be:\n%s\n\nbut got:\n%s", v.Expected, actual)
+		}
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	target := "sku"
+	serviceError := az.ServiceError{
+		Code:    "SkuNotAvailable",
+		Message: "The requested size is not available in location 'westeurope'.",
+		Target:  &target,
+	}
+
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected string
+	}{
+		{
+			Name:     "Service Error from a Long Running Operation",
+			Error:    &serviceError,
+			Expected: "SkuNotAvailable (Target \"sku\"): The requested size is not available in location 'westeurope'.\n  Hint: the requested SKU/Size isn't available in this Region for this Subscription - check the SKUs which are available using `az vm list-skus --location <location>` (or the equivalent for this service)",
+		},
+		{
+			Name: "Request Error",
+			Error: autorest.DetailedError{
+				Original: &az.RequestError{
+					ServiceError: &az.ServiceError{
+						Code:    "QuotaExceeded",
+						Message: "The quota has been exceeded.",
+					},
+				},
+				StatusCode: http.StatusConflict,
+			},
+			Expected: "QuotaExceeded: The quota has been exceeded.\n  Hint: a quota for this Subscription/Region would be exceeded - request a quota increase from the Azure Portal (Help + support) or use a different Region or SKU",
+		},
+		{
+			Name: "Detailed Error with a Response Body",
+			Error: autorest.DetailedError{
+				ServiceError: []byte(`{"error": {"code": "ResourceNotFound", "message": "The Resource was not found."}}`),
+				StatusCode:   http.StatusNotFound,
+			},
+			Expected: "ResourceNotFound: The Resource was not found.",
+		},
+		{
+			Name:     "Other Error",
+			Error:    autorest.NewError("example", "Get", "Failure sending request"),
+			Expected: "example#Get: Failure sending request: StatusCode=0",
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := FormatError(v.Error); actual != v.Expected {
+			t.Fatalf("Expected the message to be:\n%s\n\nbut got:\n%s", v.Expected, actual)
+		}
+	}
+}
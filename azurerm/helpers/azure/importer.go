@@ -0,0 +1,25 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ResourceIDParser parses the specified Resource ID, returning an error if it's not valid for the Resource Type
+type ResourceIDParser func(input string) error
+
+// ValidateResourceIDPriorToImport returns a ResourceImporter which validates the ID being imported using the
+// specified parser - such that an ID for a different type of resource is rejected at import time, rather than
+// failing later on during the Read
+func ValidateResourceIDPriorToImport(idParser ResourceIDParser) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if err := idParser(d.Id()); err != nil {
+				return []*schema.ResourceData{d}, fmt.Errorf("The ID %q isn't valid for this Resource Type: %+v", d.Id(), err)
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
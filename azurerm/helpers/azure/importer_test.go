@@ -0,0 +1,50 @@
+package azure
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestValidateResourceIDPriorToImport(t *testing.T) {
+	importer := ValidateResourceIDPriorToImport(func(input string) error {
+		if input != "valid" {
+			return fmt.Errorf("expected `valid` but got %q", input)
+		}
+
+		return nil
+	})
+
+	testCases := []struct {
+		Name        string
+		ID          string
+		ExpectError bool
+	}{
+		{
+			Name:        "Valid ID",
+			ID:          "valid",
+			ExpectError: false,
+		},
+		{
+			Name:        "Invalid ID",
+			ID:          "invalid",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := (&schema.Resource{}).Data(nil)
+		d.SetId(v.ID)
+
+		_, err := importer.State(d, nil)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
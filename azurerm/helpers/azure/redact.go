@@ -0,0 +1,250 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// sensitiveHeaders are the (lower-cased) names of HTTP headers which are always redacted
+var sensitiveHeaders = map[string]bool{
+	"authorization":             true,
+	"cookie":                    true,
+	"ocp-apim-subscription-key": true,
+	"proxy-authorization":       true,
+	"set-cookie":                true,
+	"x-ms-copy-source":          true,
+}
+
+// sensitiveHeaderFragments identifies `x-ms-*` headers containing credentials, such as
+// `x-ms-encryption-key` or `x-ms-copy-source-authorization`
+var sensitiveHeaderFragments = []string{"key", "secret", "token", "signature", "authorization", "password"}
+
+// sensitiveFieldSuffixes identifies JSON fields containing credentials - field names are
+// lower-cased with any `_` and `-` removed prior to comparison, such that
+// `administratorLoginPassword`, `admin_password` and `client_secret` are all matched
+var sensitiveFieldSuffixes = []string{
+	"accesskey",
+	"accesstoken",
+	"accountkey",
+	"connectionstring",
+	"functionkeys",
+	"idtoken",
+	"masterkey",
+	"password",
+	"primarykey",
+	"refreshtoken",
+	"sastoken",
+	"secondarykey",
+	"secret",
+	"sharedkey",
+	"systemkeys",
+}
+
+var (
+	// connection strings embedded in other values, e.g. App Settings or the output of ListKeys
+	connectionStringSecretRegex = regexp.MustCompile(`(?i)((?:AccountKey|SharedAccessKey|SharedAccessSignature|Password|Pwd)=)([^;"&\s]+)`)
+
+	// SAS Tokens (and Function Keys) embedded in other values, e.g. the `x-ms-copy-source` URI or a Template Link
+	sasSignatureRegex = regexp.MustCompile(`(?i)([?&](?:sig|code)=)([^&"\s]+)`)
+
+	// used when the body isn't valid JSON (e.g. when it's been chunked) to redact sensitive JSON fields
+	jsonFieldRegex = regexp.MustCompile(`"([A-Za-z0-9_\-]+)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+)
+
+// redactHTTPDump redacts any credentials contained within the wire-format representation
+// of a HTTP Request or Response (as returned from `httputil.DumpRequestOut` / `httputil.DumpResponse`)
+func redactHTTPDump(dump []byte) string {
+	separator := []byte("\r\n\r\n")
+	index := bytes.Index(dump, separator)
+	if index == -1 {
+		return redactHeaderBlock(string(dump))
+	}
+
+	head := redactHeaderBlock(string(dump[:index]))
+	body := redactBody(dump[index+len(separator):])
+	return head + string(separator) + body
+}
+
+func redactHeaderBlock(input string) string {
+	lines := strings.Split(input, "\r\n")
+	for i, line := range lines {
+		if i == 0 {
+			// the Request Line (or Status Line) can contain a SAS Token in the URI
+			lines[i] = redactURLString(line)
+			continue
+		}
+
+		segments := strings.SplitN(line, ":", 2)
+		if len(segments) != 2 {
+			continue
+		}
+
+		if isSensitiveHeader(segments[0]) {
+			lines[i] = segments[0] + ": " + redactedValue
+			continue
+		}
+
+		lines[i] = segments[0] + ":" + redactString(segments[1])
+	}
+
+	return strings.Join(lines, "\r\n")
+}
+
+func isSensitiveHeader(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if sensitiveHeaders[name] {
+		return true
+	}
+
+	if !strings.HasPrefix(name, "x-ms-") {
+		return false
+	}
+
+	for _, fragment := range sensitiveHeaderFragments {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactBody(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return string(body)
+	}
+
+	var parsed interface{}
+	decoder := json.NewDecoder(bytes.NewReader(trimmed))
+	decoder.UseNumber()
+	if err := decoder.Decode(&parsed); err == nil && !decoder.More() {
+		redacted := redactJSONValue(parsed, "")
+
+		buffer := bytes.Buffer{}
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(redacted); err == nil {
+			return strings.TrimSuffix(buffer.String(), "\n")
+		}
+	}
+
+	// OAuth Token requests are form-encoded
+	if values, err := url.ParseQuery(string(trimmed)); err == nil && len(values) > 0 && !bytes.ContainsAny(trimmed, " \r\n{") {
+		for key := range values {
+			if isSensitiveField(key) || key == "client_assertion" || key == "assertion" {
+				values.Set(key, redactedValue)
+			}
+		}
+		return values.Encode()
+	}
+
+	// fall back to redacting anything which looks sensitive in the raw body
+	output := jsonFieldRegex.ReplaceAllStringFunc(string(body), func(match string) string {
+		groups := jsonFieldRegex.FindStringSubmatch(match)
+		if isSensitiveField(groups[1]) {
+			return `"` + groups[1] + `"` + groups[2] + `"` + redactedValue + `"`
+		}
+		return match
+	})
+	return redactString(output)
+}
+
+// redactJSONValue walks the decoded JSON document, redacting any sensitive fields
+func redactJSONValue(input interface{}, parentKey string) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		redactValueField := isSensitiveValueContainer(v, parentKey)
+		for key, val := range v {
+			if val == nil {
+				continue
+			}
+
+			if isSensitiveField(key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			if _, isString := val.(string); isString && key == "value" && redactValueField {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactJSONValue(val, key)
+		}
+		return v
+
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactJSONValue(val, parentKey)
+		}
+		return v
+
+	case string:
+		return redactString(v)
+	}
+
+	return input
+}
+
+// isSensitiveValueContainer determines if the `value` field within this object is a credential
+// rather than a list of results - for example Storage Account Keys (which have a sibling `keyName`),
+// Azure Active Directory Password Credentials (which have a sibling `keyId`), Key Vault Secrets
+// (which have an `id` within `/secrets/`) or Kubernetes Cluster credentials
+func isSensitiveValueContainer(input map[string]interface{}, parentKey string) bool {
+	if _, ok := input["keyName"]; ok {
+		return true
+	}
+
+	if _, ok := input["keyId"]; ok {
+		return true
+	}
+
+	if id, ok := input["id"].(string); ok && strings.Contains(strings.ToLower(id), "/secrets/") {
+		return true
+	}
+
+	switch strings.ToLower(parentKey) {
+	case "kubeconfigs", "keys", "passwordcredentials", "secrets":
+		return true
+	}
+
+	return false
+}
+
+func isSensitiveField(name string) bool {
+	normalized := strings.ToLower(name)
+	normalized = strings.Replace(normalized, "_", "", -1)
+	normalized = strings.Replace(normalized, "-", "", -1)
+
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactString(input string) string {
+	output := connectionStringSecretRegex.ReplaceAllString(input, "${1}"+redactedValue)
+	return sasSignatureRegex.ReplaceAllString(output, "${1}"+redactedValue)
+}
+
+func redactURLString(input string) string {
+	return sasSignatureRegex.ReplaceAllString(input, "${1}"+redactedValue)
+}
+
+// redactURL returns the string representation of the URL with any SAS Tokens or Function Keys redacted
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	return redactURLString(input.String())
+}
@@ -0,0 +1,271 @@
+package azure
+
+import (
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRedactHTTPDump(t *testing.T) {
+	testCases := []struct {
+		File     string
+		Redacted []string
+		Retained []string
+	}{
+		{
+			File: "api_management_subscription_request.txt",
+			Redacted: []string{
+				"0123456789abcdef0123456789abcdef",
+			},
+			Retained: []string{
+				"GET /echo/resource?param1=sample HTTP/1.1",
+				"Ocp-Apim-Subscription-Key: [REDACTED]",
+			},
+		},
+		{
+			File: "app_service_create_request.txt",
+			Redacted: []string{
+				"eyJ0eXAiOiJKV1QiLCJhbGciOiJSUzI1NiJ9",
+				"c3RvcmFnZS1hY2NvdW50LWtleQ==",
+				"thisIsDog11",
+			},
+			Retained: []string{
+				"Authorization: [REDACTED]",
+				"WEBSITE_NODE_DEFAULT_VERSION",
+				"10.6.0",
+				"AccountName=examplestorageacc",
+				`"type":"SQLAzure"`,
+			},
+		},
+		{
+			File: "graph_application_create_request.txt",
+			Redacted: []string{
+				"eyJ0eXAiOiJKV1QiLCJhbGciOiJSUzI1NiJ9",
+				"Gr4ph-4pp-S3cr3t",
+			},
+			Retained: []string{
+				`"displayName":"example-app"`,
+				`"keyId":"33333333-3333-3333-3333-333333333333"`,
+				`"value":"[REDACTED]"`,
+			},
+		},
+		{
+			File: "graph_service_principal_password_request.txt",
+			Redacted: []string{
+				"Sp-P4ssw0rd-S3cr3t",
+			},
+			Retained: []string{
+				`"keyId":"33333333-3333-3333-3333-333333333333"`,
+				`"endDate":"2020-01-01T01:02:03Z"`,
+				`"value":"[REDACTED]"`,
+			},
+		},
+		{
+			File: "key_vault_secret_response.txt",
+			Redacted: []string{
+				"szechuan-sauce-recipe",
+			},
+			Retained: []string{
+				"https://example-keyvault.vault.azure.net/secrets/example-secret/a1b2c3d4e5f64718293a4b5c6d7e8f90",
+				`"contentType":"text/plain"`,
+				"X-Ms-Request-Id: 8a2c6d0e-0f7e-4b8a-9a3d-7b2e5d8e8c14",
+			},
+		},
+		{
+			File: "kubernetes_cluster_create_request.txt",
+			Redacted: []string{
+				"aks-sp-client-secret",
+			},
+			Retained: []string{
+				"22222222-2222-2222-2222-222222222222",
+				"ssh-rsa AAAAB3NzaC1yc2E terraform@demo.tld",
+			},
+		},
+		{
+			File: "kubernetes_cluster_credentials_response.txt",
+			Redacted: []string{
+				"YXBpVmVyc2lvbjogdjEKY2x1c3RlcnM6IGZha2Uta3ViZWNvbmZpZw==",
+			},
+			Retained: []string{
+				`"name":"clusterAdmin"`,
+			},
+		},
+		{
+			File:     "resource_group_list_response.txt",
+			Redacted: []string{},
+			Retained: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+				`"environment":"production"`,
+				"X-Ms-Ratelimit-Remaining-Subscription-Reads: 11999",
+			},
+		},
+		{
+			File: "service_principal_token_request.txt",
+			Redacted: []string{
+				"Pa55w0rd",
+			},
+			Retained: []string{
+				"client_id=11111111-1111-1111-1111-111111111111",
+				"grant_type=client_credentials",
+			},
+		},
+		{
+			File: "sql_server_create_request.txt",
+			Redacted: []string{
+				"eyJ0eXAiOiJKV1QiLCJhbGciOiJSUzI1NiJ9",
+				"thisIsDog11",
+			},
+			Retained: []string{
+				"PUT /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Sql/servers/example-sqlserver?api-version=2015-05-01-preview HTTP/1.1",
+				`"administratorLogin":"mradministrator"`,
+				`"administratorLoginPassword":"[REDACTED]"`,
+			},
+		},
+		{
+			File: "storage_blob_copy_request.txt",
+			Redacted: []string{
+				"zfMRdPbX",
+				"b0tSM2N1cmUlMkJzaWduYXR1cmU",
+				"Q2hhbmdlTWU9PQ==",
+				"MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+			},
+			Retained: []string{
+				"sv=2017-07-29",
+				"sig=[REDACTED]",
+				"X-Ms-Version: 2016-05-31",
+			},
+		},
+		{
+			File: "storage_list_keys_response.txt",
+			Redacted: []string{
+				"bm90LWEtcmVhbC1rZXktMQ0K3qNjE2fG1Q4FZcTg2a1J0Wn5cRlVZbXp2YkpVbnZ4bnN3PT0=",
+				"bm90LWEtcmVhbC1rZXktMg0KaGVsbG8td29ybGQtdGhpcy1pcy1ub3QtYS1zZWNyZXQ9PQ==",
+			},
+			Retained: []string{
+				`"keyName":"key1"`,
+				`"permissions":"FULL"`,
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.File)
+
+		dump, err := ioutil.ReadFile(filepath.Join("testdata", v.File))
+		if err != nil {
+			t.Fatalf("Error loading %q: %+v", v.File, err)
+		}
+
+		actual := redactHTTPDump(dump)
+
+		for _, secret := range v.Redacted {
+			if !strings.Contains(string(dump), secret) {
+				t.Fatalf("Expected the recorded payload %q to contain %q", v.File, secret)
+			}
+
+			if strings.Contains(actual, secret) {
+				t.Fatalf("Expected %q to be redacted from %q but got:\n%s", secret, v.File, actual)
+			}
+		}
+
+		for _, value := range v.Retained {
+			if !strings.Contains(actual, value) {
+				t.Fatalf("Expected %q to be retained in %q but got:\n%s", value, v.File, actual)
+			}
+		}
+	}
+}
+
+func TestRedactHTTPDumpChunkedBody(t *testing.T) {
+	dump := "HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n5c\r\n{\"primaryKey\": \"cHJpbWFyeQ==\", \"secondaryKey\": \"c2Vjb25kYXJ5\", \"keyName\": \"RootManageSharedAccessKey\"}\r\n0\r\n\r\n"
+
+	actual := redactHTTPDump([]byte(dump))
+	for _, secret := range []string{"cHJpbWFyeQ==", "c2Vjb25kYXJ5"} {
+		if strings.Contains(actual, secret) {
+			t.Fatalf("Expected %q to be redacted but got:\n%s", secret, actual)
+		}
+	}
+
+	if !strings.Contains(actual, "RootManageSharedAccessKey") {
+		t.Fatalf("Expected the `keyName` to be retained but got:\n%s", actual)
+	}
+}
+
+func TestIsSensitiveHeader(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Sensitive bool
+	}{
+		{
+			Name:      "Authorization",
+			Sensitive: true,
+		},
+		{
+			Name:      "ocp-apim-subscription-key",
+			Sensitive: true,
+		},
+		{
+			Name:      "X-Ms-Encryption-Key",
+			Sensitive: true,
+		},
+		{
+			Name:      "x-ms-copy-source-authorization",
+			Sensitive: true,
+		},
+		{
+			Name:      "X-Ms-Request-Id",
+			Sensitive: false,
+		},
+		{
+			Name:      "X-Ms-Ratelimit-Remaining-Subscription-Writes",
+			Sensitive: false,
+		},
+		{
+			Name:      "Content-Type",
+			Sensitive: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := isSensitiveHeader(v.Name); actual != v.Sensitive {
+			t.Fatalf("Expected %q to be sensitive %t but got %t", v.Name, v.Sensitive, actual)
+		}
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000?api-version=2016-06-01",
+			Expected: "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000?api-version=2016-06-01",
+		},
+		{
+			Input:    "https://example.blob.core.windows.net/container/blob?sv=2017-07-29&sig=c2lnbmF0dXJl&se=2018-12-01",
+			Expected: "https://example.blob.core.windows.net/container/blob?sv=2017-07-29&sig=[REDACTED]&se=2018-12-01",
+		},
+		{
+			Input:    "https://example.azurewebsites.net/api/HttpTrigger?code=ZnVuY3Rpb24ta2V5",
+			Expected: "https://example.azurewebsites.net/api/HttpTrigger?code=[REDACTED]",
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		input, err := url.Parse(v.Input)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", v.Input, err)
+		}
+
+		if actual := redactURL(input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
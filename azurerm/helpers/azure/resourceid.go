@@ -54,7 +54,7 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 
 		// Catch the subscriptionID before it can be overwritten by another "subscriptions"
 		// value in the ID which is the case for the Service Bus subscription resource
-		if key == "subscriptions" && subscriptionID == "" {
+		if strings.EqualFold(key, "subscriptions") && subscriptionID == "" {
 			subscriptionID = value
 		} else {
 			componentMap[key] = value
@@ -71,26 +71,58 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 		return nil, fmt.Errorf("No subscription ID found in: %q", path)
 	}
 
-	if resourceGroup, ok := componentMap["resourceGroups"]; ok {
+	// Some Azure APIs are weird and provide things in lower case...
+	// so we look for the Resource Group and Provider segments regardless of casing
+	if resourceGroup, ok := popSegmentIgnoringCase(componentMap, "resourceGroups"); ok {
 		idObj.ResourceGroup = resourceGroup
-		delete(componentMap, "resourceGroups")
 	} else {
-		// Some Azure APIs are weird and provide things in lower case...
-		// However it's not clear whether the casing of other elements in the URI
-		// matter, so we explicitly look for that case here.
-		if resourceGroup, ok := componentMap["resourcegroups"]; ok {
-			idObj.ResourceGroup = resourceGroup
-			delete(componentMap, "resourcegroups")
-		} else {
-			return nil, fmt.Errorf("No resource group name found in: %q", path)
-		}
+		return nil, fmt.Errorf("No resource group name found in: %q", path)
 	}
 
 	// It is OK not to have a provider in the case of a resource group
-	if provider, ok := componentMap["providers"]; ok {
+	if provider, ok := popSegmentIgnoringCase(componentMap, "providers"); ok {
 		idObj.Provider = provider
-		delete(componentMap, "providers")
 	}
 
 	return idObj, nil
 }
+
+// PopSegment retrieves a segment from the Path and returns it
+// if found. If it is not found an error is returned. The segment
+// name is matched case-insensitively, since Azure doesn't always
+// return IDs using the same casing they were submitted with.
+func (id *ResourceID) PopSegment(name string) (string, error) {
+	value, ok := popSegmentIgnoringCase(id.Path, name)
+	if !ok {
+		return "", fmt.Errorf("ID was missing the `%s` element", name)
+	}
+
+	return value, nil
+}
+
+// ValidateNoEmptySegments validates that there are no segments left
+// in the Path once all of the expected segments have been popped -
+// which would indicate this ID is for a different (child) resource.
+func (id *ResourceID) ValidateNoEmptySegments(sourceId string) error {
+	if len(id.Path) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("ID contained more segments than required: %q, %v", sourceId, id.Path)
+}
+
+func popSegmentIgnoringCase(segments map[string]string, name string) (string, bool) {
+	if value, ok := segments[name]; ok {
+		delete(segments, name)
+		return value, true
+	}
+
+	for key, value := range segments {
+		if strings.EqualFold(key, name) {
+			delete(segments, key)
+			return value, true
+		}
+	}
+
+	return "", false
+}
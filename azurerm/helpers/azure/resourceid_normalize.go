@@ -0,0 +1,58 @@
+package azure
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// NormalizeResourceID normalises an Azure Resource Manager ID segment by segment - such that the well-known
+// segments (`subscriptions`, `resourceGroups` and `providers`) use their canonical casing, the Subscription ID
+// is lower-cased and any empty segments (e.g. from a trailing or doubled `/`) are removed.
+// Values which aren't Resource IDs (e.g. those not starting with a `/`) are returned as-is.
+func NormalizeResourceID(input interface{}) string {
+	id := input.(string)
+	if !strings.HasPrefix(strings.TrimSpace(id), "/") {
+		return id
+	}
+
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(strings.TrimSpace(id), "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	for i := 0; i < len(segments); i += 2 {
+		key := segments[i]
+		switch {
+		case strings.EqualFold(key, "subscriptions"):
+			segments[i] = "subscriptions"
+			if i+1 < len(segments) {
+				segments[i+1] = strings.ToLower(segments[i+1])
+			}
+
+		case strings.EqualFold(key, "resourceGroups"):
+			segments[i] = "resourceGroups"
+
+		case strings.EqualFold(key, "providers"):
+			segments[i] = "providers"
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+// SuppressResourceIDDiff suppresses the diff between two Resource IDs which refer to the same resource - since
+// Resource Manager treats IDs case-insensitively, but frequently returns them using a different casing to
+// the one which was specified (e.g. `resourcegroups` rather than `resourceGroups`)
+func SuppressResourceIDDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(NormalizeResourceID(old), NormalizeResourceID(new))
+}
+
+// HashResourceID hashes a Resource ID case-insensitively for use in a Set of Resource IDs - such that a Resource ID
+// returned using a different casing to the one which was specified hashes to the same value
+func HashResourceID(v interface{}) int {
+	return hashcode.String(strings.ToLower(NormalizeResourceID(v)))
+}
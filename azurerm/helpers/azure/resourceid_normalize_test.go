@@ -0,0 +1,159 @@
+package azure
+
+import "testing"
+
+func TestNormalizeResourceID(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "Not a Resource ID",
+			Input:    "hello-world",
+			Expected: "hello-world",
+		},
+		{
+			Name:     "Subscription",
+			Input:    "/subscriptions/6D74BDD2-9F84-11E5-9BD9-7831C1C4C038",
+			Expected: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+		},
+		{
+			Name:     "Canonical Resource Group",
+			Input:    "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Expected: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+		},
+		{
+			Name:     "Lower-cased Resource Group",
+			Input:    "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourcegroups/testGroup1",
+			Expected: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+		},
+		{
+			Name:     "Upper-cased Resource",
+			Input:    "/SUBSCRIPTIONS/6D74BDD2-9F84-11E5-9BD9-7831C1C4C038/RESOURCEGROUPS/testGroup1/PROVIDERS/Microsoft.Network/virtualNetworks/net1",
+			Expected: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/Microsoft.Network/virtualNetworks/net1",
+		},
+		{
+			Name:     "Empty Segments",
+			Input:    "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038//resourceGroups/testGroup1/",
+			Expected: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+		},
+		{
+			Name:     "Management Group",
+			Input:    "/PROVIDERS/Microsoft.Management/managementGroups/group1",
+			Expected: "/providers/Microsoft.Management/managementGroups/group1",
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := NormalizeResourceID(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestSuppressResourceIDDiff(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "Empty",
+			Old:      "",
+			New:      "",
+			Suppress: true,
+		},
+		{
+			Name:     "Newly Set",
+			Old:      "",
+			New:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Suppress: false,
+		},
+		{
+			Name:     "Identical",
+			Old:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			New:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Suppress: true,
+		},
+		{
+			Name:     "Different Casing",
+			Old:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourcegroups/TESTGROUP1/providers/microsoft.network/virtualnetworks/NET1",
+			New:      "/subscriptions/6D74BDD2-9F84-11E5-9BD9-7831C1C4C038/resourceGroups/testGroup1/providers/Microsoft.Network/virtualNetworks/net1",
+			Suppress: true,
+		},
+		{
+			Name:     "Trailing Slash",
+			Old:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/",
+			New:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Suppress: true,
+		},
+		{
+			Name:     "Different Resource",
+			Old:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			New:      "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup2",
+			Suppress: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := SuppressResourceIDDiff("", v.Old, v.New, nil)
+		if actual != v.Suppress {
+			t.Fatalf("Expected %t but got %t", v.Suppress, actual)
+		}
+	}
+}
+
+func TestHashResourceID(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		First string
+		Other string
+		Equal bool
+	}{
+		{
+			Name:  "Identical",
+			First: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Other: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Equal: true,
+		},
+		{
+			Name:  "Different Casing",
+			First: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourcegroups/TESTGROUP1/providers/microsoft.network/applicationSecurityGroups/ASG1",
+			Other: "/subscriptions/6D74BDD2-9F84-11E5-9BD9-7831C1C4C038/resourceGroups/testGroup1/providers/Microsoft.Network/applicationSecurityGroups/asg1",
+			Equal: true,
+		},
+		{
+			Name:  "Trailing Slash",
+			First: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/",
+			Other: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Equal: true,
+		},
+		{
+			Name:  "Different Resource",
+			First: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1",
+			Other: "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup2",
+			Equal: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := HashResourceID(v.First) == HashResourceID(v.Other)
+		if actual != v.Equal {
+			t.Fatalf("Expected %t but got %t", v.Equal, actual)
+		}
+	}
+}
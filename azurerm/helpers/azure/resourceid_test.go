@@ -127,6 +127,18 @@ func TestParseAzureResourceID(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"/SUBSCRIPTIONS/34ca515c-4629-458e-bf7c-738d77e0d0ea/RESOURCEGROUPS/testGroup1/PROVIDERS/Microsoft.Network/networkSecurityGroups/testGroup1",
+			&ResourceID{
+				SubscriptionID: "34ca515c-4629-458e-bf7c-738d77e0d0ea",
+				ResourceGroup:  "testGroup1",
+				Provider:       "Microsoft.Network",
+				Path: map[string]string{
+					"networkSecurityGroups": "testGroup1",
+				},
+			},
+			false,
+		},
 	}
 
 	for _, test := range testCases {
@@ -143,3 +155,82 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Segment       string
+		ExpectedValue string
+		ExpectError   bool
+	}{
+		{
+			Name:          "Exact Match",
+			Segment:       "virtualNetworks",
+			ExpectedValue: "network1",
+		},
+		{
+			Name:          "Different Casing",
+			Segment:       "VIRTUALNETWORKS",
+			ExpectedValue: "network1",
+		},
+		{
+			Name:        "Missing Segment",
+			Segment:     "subnets",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		id, err := ParseAzureResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1")
+		if err != nil {
+			t.Fatalf("Error parsing ID: %+v", err)
+		}
+
+		actual, err := id.PopSegment(v.Segment)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != v.ExpectedValue {
+			t.Fatalf("Expected %q but got %q", v.ExpectedValue, actual)
+		}
+
+		if len(id.Path) != 0 {
+			t.Fatalf("Expected the segment to be removed from the Path but got %+v", id.Path)
+		}
+	}
+}
+
+func TestResourceIDValidateNoEmptySegments(t *testing.T) {
+	input := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	id, err := ParseAzureResourceID(input)
+	if err != nil {
+		t.Fatalf("Error parsing ID: %+v", err)
+	}
+
+	if _, err := id.PopSegment("virtualNetworks"); err != nil {
+		t.Fatalf("Error popping segment: %+v", err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err == nil {
+		t.Fatalf("Expected an error since the `subnets` segment remains but didn't get one")
+	}
+
+	if _, err := id.PopSegment("subnets"); err != nil {
+		t.Fatalf("Error popping segment: %+v", err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+}
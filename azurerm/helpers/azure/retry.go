@@ -0,0 +1,194 @@
+package azure
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a throttled or failed request is retried by default
+	DefaultMaxRetries = 8
+
+	// DefaultMaxRetryBackoff is the longest we'll wait between two attempts by default
+	DefaultMaxRetryBackoff = 60 * time.Second
+
+	// baseRetryBackoff is the delay before the first retry, which is doubled for each subsequent attempt
+	baseRetryBackoff = 2 * time.Second
+
+	// rateLimitRemainingHeaderPrefix is the prefix of the headers ARM uses to report the number of requests
+	// remaining before throttling kicks in, e.g. `x-ms-ratelimit-remaining-subscription-writes`
+	rateLimitRemainingHeaderPrefix = "X-Ms-Ratelimit-Remaining-"
+)
+
+// retryableStatusCodes are the HTTP Status Codes returned from ARM which are safe to retry
+var retryableStatusCodes = map[int]bool{
+	http.StatusRequestTimeout:      true,
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// RetryPolicy controls how requests which are throttled (or fail with a transient error) are retried
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried before the last response is returned
+	MaxRetries int
+
+	// MaxBackoff is the upper bound on the delay between two attempts, including any `Retry-After` delay
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when one isn't specified in the Provider block
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		MaxBackoff: DefaultMaxRetryBackoff,
+	}
+}
+
+var (
+	jitter     = rand.New(rand.NewSource(time.Now().UnixNano()))
+	jitterLock = sync.Mutex{}
+)
+
+// withRetries returns a SendDecorator which retries requests which were throttled (HTTP 429) or which
+// failed with a transient error (either a 5xx or a temporary network error) using jittered exponential
+// backoff. Any `Retry-After` returned from the API takes precedence over the computed backoff.
+func withRetries(policy RetryPolicy) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			for attempt := 0; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				if attempt >= policy.MaxRetries || !shouldRetry(resp, err) {
+					return resp, err
+				}
+
+				delay := retryDelay(resp, attempt, policy)
+				if resp != nil {
+					log.Printf("[DEBUG] %s %s returned HTTP %d - retrying in %s (attempt %d of %d)", r.Method, redactURL(r.URL), resp.StatusCode, delay, attempt+1, policy.MaxRetries)
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				} else {
+					log.Printf("[DEBUG] %s %s failed with %+v - retrying in %s (attempt %d of %d)", r.Method, redactURL(r.URL), err, delay, attempt+1, policy.MaxRetries)
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		if autorest.IsTokenRefreshError(err) {
+			return false
+		}
+
+		// the response may also be populated if authentication failed - which won't succeed on a retry
+		if resp != nil {
+			return false
+		}
+
+		netErr, ok := err.(net.Error)
+		return ok && (netErr.Temporary() || netErr.Timeout())
+	}
+
+	return resp != nil && retryableStatusCodes[resp.StatusCode]
+}
+
+// retryDelay determines how long to wait before the next attempt: using the `Retry-After` header if it's
+// present, the longest possible backoff when the rate limit has been exhausted - and otherwise exponential
+// backoff with jitter (such that parallel requests throttled at the same time don't retry in lockstep)
+func retryDelay(resp *http.Response, attempt int, policy RetryPolicy) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return capBackoff(delay, policy)
+		}
+
+		if rateLimitExhausted(resp.Header) {
+			return capBackoff(policy.MaxBackoff, policy)
+		}
+	}
+
+	backoff := capBackoff(time.Duration(float64(baseRetryBackoff)*math.Pow(2, float64(attempt))), policy)
+
+	// "equal jitter" - wait at least half the backoff, so retries are still spread out exponentially
+	half := int64(backoff / 2)
+	if half <= 0 {
+		return backoff
+	}
+
+	jitterLock.Lock()
+	defer jitterLock.Unlock()
+	return time.Duration(half + jitter.Int63n(half+1))
+}
+
+func capBackoff(delay time.Duration, policy RetryPolicy) time.Duration {
+	if delay < 0 || delay > policy.MaxBackoff {
+		return policy.MaxBackoff
+	}
+
+	return delay
+}
+
+// parseRetryAfter parses the `Retry-After` header, which can be either a number of seconds or a HTTP Date
+func parseRetryAfter(input string) (time.Duration, bool) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(input); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// rateLimitExhausted determines if any of the `x-ms-ratelimit-remaining-*` headers show that no further
+// requests can be made, in which case retrying any sooner than the maximum backoff is pointless
+func rateLimitExhausted(headers http.Header) bool {
+	for name, values := range headers {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(name), rateLimitRemainingHeaderPrefix) {
+			continue
+		}
+
+		for _, value := range values {
+			if remaining, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && remaining <= 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,303 @@
+package azure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithRetries(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		StatusCodes      []int
+		MaxRetries       int
+		ExpectedRequests int
+		ExpectedStatus   int
+	}{
+		{
+			Name:             "Success",
+			StatusCodes:      []int{http.StatusOK},
+			MaxRetries:       3,
+			ExpectedRequests: 1,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Throttled then Success",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedRequests: 3,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Service Unavailable then Success",
+			StatusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedRequests: 2,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Name:             "Throttled and Unavailable then Success",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusCreated},
+			MaxRetries:       3,
+			ExpectedRequests: 4,
+			ExpectedStatus:   http.StatusCreated,
+		},
+		{
+			Name:             "Retries Exhausted",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       2,
+			ExpectedRequests: 3,
+			ExpectedStatus:   http.StatusTooManyRequests,
+		},
+		{
+			Name:             "Retries Disabled",
+			StatusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       0,
+			ExpectedRequests: 1,
+			ExpectedStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			Name:             "Client Error",
+			StatusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedRequests: 1,
+			ExpectedStatus:   http.StatusBadRequest,
+		},
+		{
+			Name:             "Conflict",
+			StatusCodes:      []int{http.StatusConflict, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedRequests: 1,
+			ExpectedStatus:   http.StatusConflict,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		server, requests := newSequenceServer(t, v.StatusCodes, "")
+		policy := RetryPolicy{
+			MaxRetries: v.MaxRetries,
+			MaxBackoff: 10 * time.Millisecond,
+		}
+
+		body := `{"location":"westeurope"}`
+		req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		sender := autorest.DecorateSender(server.Client(), withRetries(policy))
+		resp, err := sender.Do(req)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("Expected the Status Code to be %d but got %d", v.ExpectedStatus, resp.StatusCode)
+		}
+
+		if len(*requests) != v.ExpectedRequests {
+			t.Fatalf("Expected %d requests but got %d", v.ExpectedRequests, len(*requests))
+		}
+
+		// the request body must be sent in full for each attempt
+		for i, actual := range *requests {
+			if actual != body {
+				t.Fatalf("Expected the body of request %d to be %q but got %q", i, body, actual)
+			}
+		}
+	}
+}
+
+func TestWithRetriesHonoursRetryAfter(t *testing.T) {
+	server, requests := newSequenceServer(t, []int{http.StatusTooManyRequests, http.StatusOK}, "1")
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	start := time.Now()
+	sender := autorest.DecorateSender(server.Client(), withRetries(policy))
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("Expected 2 requests but got %d", len(*requests))
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected the `Retry-After` of 1s to be honoured but the request completed in %s", elapsed)
+	}
+}
+
+func TestWithRetriesCancelled(t *testing.T) {
+	server, requests := newSequenceServer(t, []int{http.StatusServiceUnavailable, http.StatusOK}, "30")
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		MaxBackoff: time.Minute,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(server.Client(), withRetries(policy))
+	if _, err := sender.Do(req.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the error to be %+v but got %+v", context.DeadlineExceeded, err)
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("Expected 1 request but got %d", len(*requests))
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 5,
+		MaxBackoff: 30 * time.Second,
+	}
+
+	testCases := []struct {
+		Name        string
+		Headers     map[string]string
+		Attempt     int
+		ExpectedMin time.Duration
+		ExpectedMax time.Duration
+	}{
+		{
+			Name:        "First Attempt",
+			Attempt:     0,
+			ExpectedMin: baseRetryBackoff / 2,
+			ExpectedMax: baseRetryBackoff,
+		},
+		{
+			Name:        "Third Attempt",
+			Attempt:     2,
+			ExpectedMin: 4 * baseRetryBackoff / 2,
+			ExpectedMax: 4 * baseRetryBackoff,
+		},
+		{
+			Name:        "Capped at the Max Backoff",
+			Attempt:     10,
+			ExpectedMin: policy.MaxBackoff / 2,
+			ExpectedMax: policy.MaxBackoff,
+		},
+		{
+			Name: "Retry After in Seconds",
+			Headers: map[string]string{
+				"Retry-After": "17",
+			},
+			Attempt:     0,
+			ExpectedMin: 17 * time.Second,
+			ExpectedMax: 17 * time.Second,
+		},
+		{
+			Name: "Retry After exceeding the Max Backoff",
+			Headers: map[string]string{
+				"Retry-After": "3600",
+			},
+			Attempt:     0,
+			ExpectedMin: policy.MaxBackoff,
+			ExpectedMax: policy.MaxBackoff,
+		},
+		{
+			Name: "Retry After in the past",
+			Headers: map[string]string{
+				"Retry-After": "Wed, 21 Oct 2015 07:28:00 GMT",
+			},
+			Attempt:     3,
+			ExpectedMin: 0,
+			ExpectedMax: 0,
+		},
+		{
+			Name: "Rate Limit Exhausted",
+			Headers: map[string]string{
+				"x-ms-ratelimit-remaining-subscription-writes": "0",
+			},
+			Attempt:     0,
+			ExpectedMin: policy.MaxBackoff,
+			ExpectedMax: policy.MaxBackoff,
+		},
+		{
+			Name: "Rate Limit Remaining",
+			Headers: map[string]string{
+				"x-ms-ratelimit-remaining-subscription-reads": "11999",
+			},
+			Attempt:     0,
+			ExpectedMin: baseRetryBackoff / 2,
+			ExpectedMax: baseRetryBackoff,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resp := &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{},
+		}
+		for key, value := range v.Headers {
+			resp.Header.Set(key, value)
+		}
+
+		actual := retryDelay(resp, v.Attempt, policy)
+		if actual < v.ExpectedMin || actual > v.ExpectedMax {
+			t.Fatalf("Expected the delay to be between %s and %s but got %s", v.ExpectedMin, v.ExpectedMax, actual)
+		}
+	}
+}
+
+// newSequenceServer returns a test server which responds with each of the specified Status Codes in turn,
+// recording the body of each request it receives
+func newSequenceServer(t *testing.T, statusCodes []int, retryAfter string) (*httptest.Server, *[]string) {
+	requests := make([]string, 0)
+	lock := sync.Mutex{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Error reading request body: %+v", err)
+		}
+
+		statusCode := statusCodes[len(requests)]
+		requests = append(requests, string(body))
+
+		if retryAfter != "" && statusCode != http.StatusOK {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(statusCode)
+	}))
+
+	return server, &requests
+}
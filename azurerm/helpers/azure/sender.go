@@ -1,55 +1,146 @@
 package azure
 
 import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
 )
 
-func BuildSender() autorest.Sender {
-	return autorest.DecorateSender(&http.Client{
+// BuildSender returns the Sender used for every client - the RequestLimiter should be shared between all of
+// the Senders for a Provider instance, so that the concurrency limits apply across all of the clients
+func BuildSender(retryPolicy RetryPolicy, limiter *RequestLimiter) autorest.Sender {
+	return DecorateSender(&http.Client{
 		Transport: &http.Transport{
 			Proxy: http.ProxyFromEnvironment,
 		},
-	}, withRequestLogging())
+	}, retryPolicy, limiter)
 }
 
-func withRequestLogging() autorest.SendDecorator {
+// DecorateSender applies the retry policy, concurrency limits, logging and correlation IDs used by each client
+// to the specified Sender, which sends the request over the wire
+func DecorateSender(sender autorest.Sender, retryPolicy RetryPolicy, limiter *RequestLimiter) autorest.Sender {
+	// decorators are applied in order, so requests are retried outside of the logging
+	// to ensure each attempt is logged (along with the correlation IDs for each attempt)
+	// and a slot is only held within the concurrency limit whilst each attempt is in-flight
+	return autorest.DecorateSender(sender, withConcurrencyLimit(limiter), withRequestLogging(), withErrorDetails(), withCorrelationIDs(), withRetries(retryPolicy))
+}
+
+// maxErrorDetailsBodySize is the largest successful response which is checked for the error from a failed
+// long-running operation - which is returned in a (small) `{"status": "Failed", "error": {..}}` body
+const maxErrorDetailsBodySize = 64 * 1024
+
+// withErrorDetails decodes the ARM error returned in each response for the current Operation (including the
+// body of a failed long-running operation), such that a concise description of the error is included in any
+// error returned for the Operation - rather than the underlying error being buried in the SDK's error message
+func withErrorDetails() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+
+			op := correlation.FromContext(r.Context())
+			if op == nil || resp == nil || resp.Body == nil {
+				return resp, err
+			}
+
+			failed := resp.StatusCode >= http.StatusBadRequest
+			if !failed && (resp.ContentLength < 0 || resp.ContentLength > maxErrorDetailsBodySize) {
+				op.SetErrorDetails("")
+				return resp, err
+			}
+
+			// the body is read here, so it must be replaced for the SDK to unmarshal the response
+			body, readErr := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			if readErr != nil {
+				return resp, err
+			}
+
+			details := ""
+			if armErr, ok := DecodeArmError(body); ok && (failed || longRunningOperationFailed(body)) {
+				details = armErr.String()
+			}
+			op.SetErrorDetails(details)
+
+			return resp, err
+		})
+	}
+}
+
+func longRunningOperationFailed(body []byte) bool {
+	var operation struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(body, &operation); err != nil {
+		return false
+	}
+
+	return strings.EqualFold(operation.Status, "Failed") || strings.EqualFold(operation.Status, "Canceled")
+}
+
+// withCorrelationIDs sends the Client Request ID for the current Operation in the `x-ms-client-request-id` header
+// (or a new ID when the request isn't part of an Operation) and records the IDs returned in the response, such that
+// the requests made during an Operation can be traced by Microsoft should it fail
+//
+// Requests signed using a Storage Account's Shared Key are sent unchanged, since the signature covers every `x-ms-`
+// header - as such the Client Request ID must be set on these prior to signing
+func withCorrelationIDs() autorest.SendDecorator {
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
-			// strip the authorization header prior to printing
-			authHeaderName := "Authorization"
-			auth := r.Header.Get(authHeaderName)
-			if auth != "" {
-				r.Header.Del(authHeaderName)
+			op := correlation.FromContext(r.Context())
+			if op == nil {
+				op = correlation.NewOperation()
 			}
 
-			// dump request to wire format
+			if r.Header == nil {
+				r.Header = http.Header{}
+			}
+			if r.Header.Get(correlation.ClientRequestIDHeader) == "" && !isSignedWithSharedKey(r) {
+				r.Header.Set(correlation.ClientRequestIDHeader, op.ClientRequestID)
+			}
+
+			op.Sent()
+			resp, err := s.Do(r)
+			op.Received(resp)
+			return resp, err
+		})
+	}
+}
+
+// isSignedWithSharedKey returns whether the request is signed using either the SharedKey or SharedKeyLite schemes
+func isSignedWithSharedKey(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey")
+}
+
+func withRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// dump request to wire format, redacting any credentials prior to printing
 			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", redactHTTPDump(dump))
 			} else {
 				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
-			}
-
-			// add the auth header back
-			if auth != "" {
-				r.Header.Add(authHeaderName, auth)
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, redactURL(r.URL))
 			}
 
 			resp, err := s.Do(r)
 			if resp != nil {
 				// dump response to wire format
 				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", redactURL(r.URL), redactHTTPDump(dump))
 				} else {
 					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, redactURL(r.URL))
 				}
 			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+				log.Printf("[DEBUG] Request to %s completed with no response", redactURL(r.URL))
 			}
 			return resp, err
 		})
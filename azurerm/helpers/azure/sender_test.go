@@ -0,0 +1,121 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
+)
+
+func TestWithCorrelationIDs(t *testing.T) {
+	var clientRequestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientRequestIDs = append(clientRequestIDs, r.Header.Get(correlation.ClientRequestIDHeader))
+
+		w.Header().Set(correlation.RequestIDHeader, "11111111-1111-1111-1111-111111111111")
+		w.Header().Set(correlation.CorrelationRequestIDHeader, "22222222-2222-2222-2222-222222222222")
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), withCorrelationIDs())
+	send := func(ctx context.Context) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		if _, err := sender.Do(req.WithContext(ctx)); err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+
+	op := correlation.NewOperation()
+	send(correlation.NewContext(context.Background(), op))
+	send(correlation.NewContext(context.Background(), op))
+	send(context.Background())
+
+	if len(clientRequestIDs) != 3 {
+		t.Fatalf("Expected 3 requests but got %d", len(clientRequestIDs))
+	}
+
+	if clientRequestIDs[0] != op.ClientRequestID || clientRequestIDs[1] != op.ClientRequestID {
+		t.Fatalf("Expected the requests in the operation to use the Client Request ID %q but got %q", op.ClientRequestID, clientRequestIDs[:2])
+	}
+
+	if clientRequestIDs[2] == "" || clientRequestIDs[2] == op.ClientRequestID {
+		t.Fatalf("Expected a new Client Request ID for a request outside of the operation but got %q", clientRequestIDs[2])
+	}
+
+	err := op.WrapError(autorest.NewError("example", "Get", "Bad Request"))
+	expected := "example#Get: Bad Request: StatusCode=0\n\nClient Request ID: " + op.ClientRequestID + "\nRequest ID: 11111111-1111-1111-1111-111111111111\nCorrelation Request ID: 22222222-2222-2222-2222-222222222222"
+	if err.Error() != expected {
+		t.Fatalf("Expected the error to be %q but got %q", expected, err.Error())
+	}
+}
+
+func TestWithErrorDetails(t *testing.T) {
+	responses := []struct {
+		StatusCode int
+		Body       string
+	}{
+		{http.StatusNotFound, `{"error": {"code": "ResourceNotFound", "message": "The Resource was not found."}}`},
+		{http.StatusCreated, `{"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"}`},
+		{http.StatusOK, `{"status": "Failed", "error": {"code": "InternalServerError", "message": "An unexpected error occurred."}}`},
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := responses[requests]
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(response.StatusCode)
+		w.Write([]byte(response.Body))
+	}))
+	defer server.Close()
+
+	op := correlation.NewOperation()
+	sender := autorest.DecorateSender(server.Client(), withErrorDetails(), withCorrelationIDs())
+	expectedDetails := []string{
+		"ResourceNotFound: The Resource was not found.",
+		"",
+		"InternalServerError: An unexpected error occurred.",
+	}
+
+	for i, response := range responses {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		resp, err := sender.Do(req.WithContext(correlation.NewContext(context.Background(), op)))
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		// the body must still be available to the SDK
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Error reading response body: %+v", err)
+		}
+		if string(body) != response.Body {
+			t.Fatalf("Expected the body to be %q but got %q", response.Body, string(body))
+		}
+
+		actual := op.WrapError(fmt.Errorf("example"))
+		expected := "example\n\n"
+		if expectedDetails[i] != "" {
+			expected += fmt.Sprintf("Azure returned:\n%s\n\n", expectedDetails[i])
+		}
+		expected += fmt.Sprintf("Client Request ID: %s", op.ClientRequestID)
+		if actual.Error() != expected {
+			t.Fatalf("Expected the error for request %d to be %q but got %q", i, expected, actual.Error())
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package correlation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// ClientRequestIDHeader is the header containing the ID we generate for each operation
+	ClientRequestIDHeader = "X-Ms-Client-Request-Id"
+
+	// RequestIDHeader is the header containing the ID Azure assigns to each request
+	RequestIDHeader = "X-Ms-Request-Id"
+
+	// CorrelationRequestIDHeader is the header containing the ID Resource Manager uses to correlate requests
+	CorrelationRequestIDHeader = "X-Ms-Correlation-Request-Id"
+)
+
+// Operation tracks the IDs needed to trace the requests made during a single Create, Read, Update or Delete
+// operation - which are sent to Azure with every request and included in any error returned to Terraform
+type Operation struct {
+	ClientRequestID string
+
+	lock                 sync.Mutex
+	sent                 bool
+	requestID            string
+	correlationRequestID string
+	errorDetails         string
+}
+
+// NewOperation returns a new Operation with a freshly generated Client Request ID
+func NewOperation() *Operation {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		log.Printf("[WARN] Failed to generate a Client Request ID: %+v", err)
+	}
+
+	return &Operation{
+		ClientRequestID: id,
+	}
+}
+
+// Sent records that a request has been sent as a part of this Operation
+func (o *Operation) Sent() {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.sent = true
+}
+
+// Received records the Request ID & Correlation Request ID from a response received as a part of this Operation,
+// such that the IDs for the most recent request (which is the one which failed, should an error occur) are retained
+func (o *Operation) Received(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if v := resp.Header.Get(RequestIDHeader); v != "" {
+		o.requestID = v
+	}
+	if v := resp.Header.Get(CorrelationRequestIDHeader); v != "" {
+		o.correlationRequestID = v
+	}
+}
+
+// SetErrorDetails records a concise description of the error returned in the most recent response (if any),
+// such that the underlying error from Azure is included in the error returned for this Operation
+func (o *Operation) SetErrorDetails(details string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.errorDetails = details
+}
+
+// WrapError appends the error details and IDs for this Operation to the error message, so that the requests can be traced by Microsoft
+func (o *Operation) WrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	// no requests were sent (e.g. the configuration is invalid) so there's nothing to trace
+	if !o.sent {
+		return err
+	}
+
+	ids := []string{fmt.Sprintf("Client Request ID: %s", o.ClientRequestID)}
+	if o.requestID != "" {
+		ids = append(ids, fmt.Sprintf("Request ID: %s", o.requestID))
+	}
+	if o.correlationRequestID != "" {
+		ids = append(ids, fmt.Sprintf("Correlation Request ID: %s", o.correlationRequestID))
+	}
+
+	// the error may already include the details if it was formatted using azure.FormatError
+	if o.errorDetails != "" && !strings.Contains(err.Error(), o.errorDetails) {
+		return fmt.Errorf("%s\n\nAzure returned:\n%s\n\n%s", err, o.errorDetails, strings.Join(ids, "\n"))
+	}
+
+	return fmt.Errorf("%s\n\n%s", err, strings.Join(ids, "\n"))
+}
+
+type contextKey struct{}
+
+// FromContext returns the Operation associated with this context, if any
+func FromContext(ctx context.Context) *Operation {
+	if ctx == nil {
+		return nil
+	}
+
+	op, _ := ctx.Value(contextKey{}).(*Operation)
+	return op
+}
+
+// WithOperation returns a context associated with the Operation being tracked for the specified ResourceData,
+// or a new Operation if this ResourceData isn't being tracked (for example when called from a unit test)
+func WithOperation(ctx context.Context, d *schema.ResourceData) context.Context {
+	op := trackedOperation(d)
+	if op == nil {
+		op = NewOperation()
+	}
+
+	return NewContext(ctx, op)
+}
+
+// NewContext returns a context associated with the specified Operation
+func NewContext(ctx context.Context, op *Operation) context.Context {
+	return context.WithValue(ctx, contextKey{}, op)
+}
+
+var (
+	operations     = make(map[*schema.ResourceData]*Operation)
+	operationsLock = sync.Mutex{}
+)
+
+func trackedOperation(d *schema.ResourceData) *Operation {
+	operationsLock.Lock()
+	defer operationsLock.Unlock()
+
+	return operations[d]
+}
+
+// Track wraps the Create, Read, Update and Delete functions of the specified Resource such that each call is tracked
+// as a single Operation (using the same Client Request ID throughout) and the IDs are included in any error returned
+func Track(r *schema.Resource) {
+	if r.Create != nil {
+		r.Create = track(r.Create)
+	}
+	if r.Read != nil {
+		r.Read = track(r.Read)
+	}
+	if r.Update != nil {
+		r.Update = track(r.Update)
+	}
+	if r.Delete != nil {
+		r.Delete = track(r.Delete)
+	}
+}
+
+func track(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		operationsLock.Lock()
+		op, nested := operations[d]
+		if !nested {
+			// Create & Update functions call the Read function once they've completed, which is part of the same Operation
+			op = NewOperation()
+			operations[d] = op
+		}
+		operationsLock.Unlock()
+
+		if nested {
+			return f(d, meta)
+		}
+
+		defer func() {
+			operationsLock.Lock()
+			delete(operations, d)
+			operationsLock.Unlock()
+		}()
+
+		return op.WrapError(f(d, meta))
+	}
+}
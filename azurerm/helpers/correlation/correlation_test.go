@@ -0,0 +1,137 @@
+package correlation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestTrack(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Responses        []map[string]string
+		Error            error
+		ExpectedError    bool
+		ExpectedContains []string
+	}{
+		{
+			Name: "Success",
+			Responses: []map[string]string{
+				{
+					RequestIDHeader: "11111111-1111-1111-1111-111111111111",
+				},
+			},
+		},
+		{
+			Name:          "Error without any requests",
+			Error:         fmt.Errorf("`name` must be specified"),
+			ExpectedError: true,
+		},
+		{
+			Name: "Error",
+			Responses: []map[string]string{
+				{
+					RequestIDHeader:            "11111111-1111-1111-1111-111111111111",
+					CorrelationRequestIDHeader: "22222222-2222-2222-2222-222222222222",
+				},
+				{
+					RequestIDHeader:            "33333333-3333-3333-3333-333333333333",
+					CorrelationRequestIDHeader: "44444444-4444-4444-4444-444444444444",
+				},
+			},
+			Error:         fmt.Errorf("Error creating Resource Group"),
+			ExpectedError: true,
+			ExpectedContains: []string{
+				"Error creating Resource Group",
+				"Client Request ID: ",
+				"Request ID: 33333333-3333-3333-3333-333333333333",
+				"Correlation Request ID: 44444444-4444-4444-4444-444444444444",
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var clientRequestIDs []string
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+			Create: func(d *schema.ResourceData, meta interface{}) error {
+				for _, headers := range v.Responses {
+					// each request made within the operation uses its own context
+					op := FromContext(WithOperation(context.Background(), d))
+					clientRequestIDs = append(clientRequestIDs, op.ClientRequestID)
+
+					resp := &http.Response{
+						Header: http.Header{},
+					}
+					for key, value := range headers {
+						resp.Header.Set(key, value)
+					}
+
+					op.Sent()
+					op.Received(resp)
+				}
+
+				return v.Error
+			},
+		}
+		Track(r)
+
+		err := r.Create(r.Data(nil), nil)
+		if err == nil {
+			if v.ExpectedError {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+		} else if !v.ExpectedError {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		for i := 1; i < len(clientRequestIDs); i++ {
+			if clientRequestIDs[i] != clientRequestIDs[0] {
+				t.Fatalf("Expected every request in the operation to use the Client Request ID %q but got %q", clientRequestIDs[0], clientRequestIDs[i])
+			}
+		}
+
+		for _, expected := range v.ExpectedContains {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("Expected the error %q to contain %q", err.Error(), expected)
+			}
+		}
+
+		if len(v.ExpectedContains) == 0 && err != nil && err.Error() != v.Error.Error() {
+			t.Fatalf("Expected the error to be %q but got %q", v.Error.Error(), err.Error())
+		}
+
+		if len(operations) != 0 {
+			t.Fatalf("Expected the operation to no longer be tracked once it completed")
+		}
+	}
+}
+
+func TestTrackUsesNewClientRequestIDForEachOperation(t *testing.T) {
+	var clientRequestIDs []string
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			op := FromContext(WithOperation(context.Background(), d))
+			clientRequestIDs = append(clientRequestIDs, op.ClientRequestID)
+			return nil
+		},
+	}
+	Track(r)
+
+	for i := 0; i < 2; i++ {
+		if err := r.Read(r.Data(nil), nil); err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+
+	if clientRequestIDs[0] == "" || clientRequestIDs[0] == clientRequestIDs[1] {
+		t.Fatalf("Expected each operation to use a new Client Request ID but got %q and %q", clientRequestIDs[0], clientRequestIDs[1])
+	}
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApiManagementID is a parsed Resource ID for a ApiManagement
+type ApiManagementID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApiManagementID returns a ApiManagementID comprised of the specified components
+func NewApiManagementID(subscriptionId, resourceGroup, name string) ApiManagementID {
+	return ApiManagementID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ApiManagement
+func (id ApiManagementID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseApiManagementID parses the specified Resource ID into a ApiManagementID
+func ParseApiManagementID(input string) (*ApiManagementID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApiManagement ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ApiManagement") {
+		return nil, fmt.Errorf("Error parsing %q as a ApiManagement ID: expected the Resource Provider %q but got %q", input, "Microsoft.ApiManagement", id.Provider)
+	}
+
+	resourceId := ApiManagementID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("service"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApiManagement ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApiManagement ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApiManagementID validates that the specified value is a ApiManagement ID
+func ValidateApiManagementID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseApiManagementID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ApiManagement ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
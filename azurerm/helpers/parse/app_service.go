@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServiceID is a parsed Resource ID for a AppService
+type AppServiceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAppServiceID returns a AppServiceID comprised of the specified components
+func NewAppServiceID(subscriptionId, resourceGroup, name string) AppServiceID {
+	return AppServiceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this AppService
+func (id AppServiceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServiceID parses the specified Resource ID into a AppServiceID
+func ParseAppServiceID(input string) (*AppServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppService ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing %q as a AppService ID: expected the Resource Provider %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServiceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppService ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppService ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServiceID validates that the specified value is a AppService ID
+func ValidateAppServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAppServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AppService ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServiceCustomHostnameBindingID is a parsed Resource ID for a AppServiceCustomHostnameBinding
+type AppServiceCustomHostnameBindingID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	SiteName       string
+	Name           string
+}
+
+// NewAppServiceCustomHostnameBindingID returns a AppServiceCustomHostnameBindingID comprised of the specified components
+func NewAppServiceCustomHostnameBindingID(subscriptionId, resourceGroup, siteName, name string) AppServiceCustomHostnameBindingID {
+	return AppServiceCustomHostnameBindingID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this AppServiceCustomHostnameBinding
+func (id AppServiceCustomHostnameBindingID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/hostNameBindings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.SiteName, id.Name)
+}
+
+// ParseAppServiceCustomHostnameBindingID parses the specified Resource ID into a AppServiceCustomHostnameBindingID
+func ParseAppServiceCustomHostnameBindingID(input string) (*AppServiceCustomHostnameBindingID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceCustomHostnameBinding ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceCustomHostnameBinding ID: expected the Resource Provider %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServiceCustomHostnameBindingID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceCustomHostnameBinding ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("hostNameBindings"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceCustomHostnameBinding ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceCustomHostnameBinding ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServiceCustomHostnameBindingID validates that the specified value is a AppServiceCustomHostnameBinding ID
+func ValidateAppServiceCustomHostnameBindingID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAppServiceCustomHostnameBindingID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AppServiceCustomHostnameBinding ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,125 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func TestAppServiceCustomHostnameBindingIDFormatter(t *testing.T) {
+	actual := NewAppServiceCustomHostnameBindingID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "appservicecustomhostnamebinding1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/appservicecustomhostnamebinding1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseAppServiceCustomHostnameBindingID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *AppServiceCustomHostnameBindingID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing SubscriptionID Value",
+			Input:    "/subscriptions/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ResourceGroup",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ResourceGroup Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Provider Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing SiteName",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing SiteName Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/",
+			Expected: nil,
+		},
+		{
+			Name:  "AppServiceCustomHostnameBinding ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/appservicecustomhostnamebinding1",
+			Expected: &AppServiceCustomHostnameBindingID{
+				SubscriptionID: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				SiteName:       "site1",
+				Name:           "appservicecustomhostnamebinding1",
+			},
+		},
+		{
+			Name:  "Upper-cased Segment Names",
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Web/SITES/site1/HOSTNAMEBINDINGS/appservicecustomhostnamebinding1",
+			Expected: &AppServiceCustomHostnameBindingID{
+				SubscriptionID: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				SiteName:       "site1",
+				Name:           "appservicecustomhostnamebinding1",
+			},
+		},
+		{
+			Name:     "Additional Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/appservicecustomhostnamebinding1/additional/segment1",
+			Expected: nil,
+		},
+		{
+			Name:     "Different Resource Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Different/sites/site1/hostNameBindings/appservicecustomhostnamebinding1",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseAppServiceCustomHostnameBindingID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value: %+v", *actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServicePlanID is a parsed Resource ID for a AppServicePlan
+type AppServicePlanID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAppServicePlanID returns a AppServicePlanID comprised of the specified components
+func NewAppServicePlanID(subscriptionId, resourceGroup, name string) AppServicePlanID {
+	return AppServicePlanID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this AppServicePlan
+func (id AppServicePlanID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/serverfarms/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServicePlanID parses the specified Resource ID into a AppServicePlanID
+func ParseAppServicePlanID(input string) (*AppServicePlanID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServicePlan ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing %q as a AppServicePlan ID: expected the Resource Provider %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServicePlanID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("serverfarms"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServicePlan ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServicePlan ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServicePlanID validates that the specified value is a AppServicePlan ID
+func ValidateAppServicePlanID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAppServicePlanID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AppServicePlan ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AppServiceSlotID is a parsed Resource ID for a AppServiceSlot
+type AppServiceSlotID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	SiteName       string
+	Name           string
+}
+
+// NewAppServiceSlotID returns a AppServiceSlotID comprised of the specified components
+func NewAppServiceSlotID(subscriptionId, resourceGroup, siteName, name string) AppServiceSlotID {
+	return AppServiceSlotID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this AppServiceSlot
+func (id AppServiceSlotID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.SiteName, id.Name)
+}
+
+// ParseAppServiceSlotID parses the specified Resource ID into a AppServiceSlotID
+func ParseAppServiceSlotID(input string) (*AppServiceSlotID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceSlot ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceSlot ID: expected the Resource Provider %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServiceSlotID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceSlot ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("slots"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceSlot ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AppServiceSlot ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServiceSlotID validates that the specified value is a AppServiceSlot ID
+func ValidateAppServiceSlotID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAppServiceSlotID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AppServiceSlot ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationGatewayID is a parsed Resource ID for a ApplicationGateway
+type ApplicationGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationGatewayID returns a ApplicationGatewayID comprised of the specified components
+func NewApplicationGatewayID(subscriptionId, resourceGroup, name string) ApplicationGatewayID {
+	return ApplicationGatewayID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ApplicationGateway
+func (id ApplicationGatewayID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationGatewayID parses the specified Resource ID into a ApplicationGatewayID
+func ParseApplicationGatewayID(input string) (*ApplicationGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationGateway ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationGateway ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationGatewayID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationGateway ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationGateway ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationGatewayID validates that the specified value is a ApplicationGateway ID
+func ValidateApplicationGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseApplicationGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ApplicationGateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
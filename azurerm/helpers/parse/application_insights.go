@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationInsightsID is a parsed Resource ID for a ApplicationInsights
+type ApplicationInsightsID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationInsightsID returns a ApplicationInsightsID comprised of the specified components
+func NewApplicationInsightsID(subscriptionId, resourceGroup, name string) ApplicationInsightsID {
+	return ApplicationInsightsID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ApplicationInsights
+func (id ApplicationInsightsID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/components/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationInsightsID parses the specified Resource ID into a ApplicationInsightsID
+func ParseApplicationInsightsID(input string) (*ApplicationInsightsID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationInsights ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationInsights ID: expected the Resource Provider %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := ApplicationInsightsID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("components"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationInsights ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationInsights ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationInsightsID validates that the specified value is a ApplicationInsights ID
+func ValidateApplicationInsightsID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseApplicationInsightsID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ApplicationInsights ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
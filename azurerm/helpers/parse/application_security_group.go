@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ApplicationSecurityGroupID is a parsed Resource ID for a ApplicationSecurityGroup
+type ApplicationSecurityGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationSecurityGroupID returns a ApplicationSecurityGroupID comprised of the specified components
+func NewApplicationSecurityGroupID(subscriptionId, resourceGroup, name string) ApplicationSecurityGroupID {
+	return ApplicationSecurityGroupID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ApplicationSecurityGroup
+func (id ApplicationSecurityGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationSecurityGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationSecurityGroupID parses the specified Resource ID into a ApplicationSecurityGroupID
+func ParseApplicationSecurityGroupID(input string) (*ApplicationSecurityGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationSecurityGroup ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationSecurityGroup ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationSecurityGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationSecurityGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationSecurityGroup ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ApplicationSecurityGroup ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationSecurityGroupID validates that the specified value is a ApplicationSecurityGroup ID
+func ValidateApplicationSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseApplicationSecurityGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ApplicationSecurityGroup ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationAccountID is a parsed Resource ID for a AutomationAccount
+type AutomationAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAutomationAccountID returns a AutomationAccountID comprised of the specified components
+func NewAutomationAccountID(subscriptionId, resourceGroup, name string) AutomationAccountID {
+	return AutomationAccountID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this AutomationAccount
+func (id AutomationAccountID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAutomationAccountID parses the specified Resource ID into a AutomationAccountID
+func ParseAutomationAccountID(input string) (*AutomationAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationAccount ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationAccount ID: expected the Resource Provider %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationAccountID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationAccount ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationAccount ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationAccountID validates that the specified value is a AutomationAccount ID
+func ValidateAutomationAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAutomationAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AutomationAccount ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationCredentialID is a parsed Resource ID for a AutomationCredential
+type AutomationCredentialID struct {
+	SubscriptionID        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationCredentialID returns a AutomationCredentialID comprised of the specified components
+func NewAutomationCredentialID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationCredentialID {
+	return AutomationCredentialID{
+		SubscriptionID:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// ID returns the Resource ID for this AutomationCredential
+func (id AutomationCredentialID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/credentials/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationCredentialID parses the specified Resource ID into a AutomationCredentialID
+func ParseAutomationCredentialID(input string) (*AutomationCredentialID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationCredential ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationCredential ID: expected the Resource Provider %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationCredentialID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationCredential ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("credentials"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationCredential ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationCredential ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationCredentialID validates that the specified value is a AutomationCredential ID
+func ValidateAutomationCredentialID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAutomationCredentialID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AutomationCredential ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationDscConfigurationID is a parsed Resource ID for a AutomationDscConfiguration
+type AutomationDscConfigurationID struct {
+	SubscriptionID        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationDscConfigurationID returns a AutomationDscConfigurationID comprised of the specified components
+func NewAutomationDscConfigurationID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationDscConfigurationID {
+	return AutomationDscConfigurationID{
+		SubscriptionID:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// ID returns the Resource ID for this AutomationDscConfiguration
+func (id AutomationDscConfigurationID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationDscConfigurationID parses the specified Resource ID into a AutomationDscConfigurationID
+func ParseAutomationDscConfigurationID(input string) (*AutomationDscConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscConfiguration ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscConfiguration ID: expected the Resource Provider %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationDscConfigurationID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscConfiguration ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("configurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscConfiguration ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscConfiguration ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationDscConfigurationID validates that the specified value is a AutomationDscConfiguration ID
+func ValidateAutomationDscConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAutomationDscConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AutomationDscConfiguration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
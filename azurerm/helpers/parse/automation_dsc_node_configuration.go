@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationDscNodeConfigurationID is a parsed Resource ID for a AutomationDscNodeConfiguration
+type AutomationDscNodeConfigurationID struct {
+	SubscriptionID        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationDscNodeConfigurationID returns a AutomationDscNodeConfigurationID comprised of the specified components
+func NewAutomationDscNodeConfigurationID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationDscNodeConfigurationID {
+	return AutomationDscNodeConfigurationID{
+		SubscriptionID:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// ID returns the Resource ID for this AutomationDscNodeConfiguration
+func (id AutomationDscNodeConfigurationID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/nodeConfigurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationDscNodeConfigurationID parses the specified Resource ID into a AutomationDscNodeConfigurationID
+func ParseAutomationDscNodeConfigurationID(input string) (*AutomationDscNodeConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscNodeConfiguration ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscNodeConfiguration ID: expected the Resource Provider %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationDscNodeConfigurationID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscNodeConfiguration ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("nodeConfigurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscNodeConfiguration ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationDscNodeConfiguration ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationDscNodeConfigurationID validates that the specified value is a AutomationDscNodeConfiguration ID
+func ValidateAutomationDscNodeConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAutomationDscNodeConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AutomationDscNodeConfiguration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationModuleID is a parsed Resource ID for a AutomationModule
+type AutomationModuleID struct {
+	SubscriptionID        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationModuleID returns a AutomationModuleID comprised of the specified components
+func NewAutomationModuleID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationModuleID {
+	return AutomationModuleID{
+		SubscriptionID:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// ID returns the Resource ID for this AutomationModule
+func (id AutomationModuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/modules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationModuleID parses the specified Resource ID into a AutomationModuleID
+func ParseAutomationModuleID(input string) (*AutomationModuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationModule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationModule ID: expected the Resource Provider %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationModuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationModule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("modules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationModule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationModule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationModuleID validates that the specified value is a AutomationModule ID
+func ValidateAutomationModuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAutomationModuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AutomationModule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
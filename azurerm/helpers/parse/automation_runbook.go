@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutomationRunbookID is a parsed Resource ID for a AutomationRunbook
+type AutomationRunbookID struct {
+	SubscriptionID        string
+	ResourceGroup         string
+	AutomationAccountName string
+	Name                  string
+}
+
+// NewAutomationRunbookID returns a AutomationRunbookID comprised of the specified components
+func NewAutomationRunbookID(subscriptionId, resourceGroup, automationAccountName, name string) AutomationRunbookID {
+	return AutomationRunbookID{
+		SubscriptionID:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		AutomationAccountName: automationAccountName,
+		Name:                  name,
+	}
+}
+
+// ID returns the Resource ID for this AutomationRunbook
+func (id AutomationRunbookID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/runbooks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AutomationAccountName, id.Name)
+}
+
+// ParseAutomationRunbookID parses the specified Resource ID into a AutomationRunbookID
+func ParseAutomationRunbookID(input string) (*AutomationRunbookID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationRunbook ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Automation") {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationRunbook ID: expected the Resource Provider %q but got %q", input, "Microsoft.Automation", id.Provider)
+	}
+
+	resourceId := AutomationRunbookID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationRunbook ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("runbooks"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationRunbook ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutomationRunbook ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutomationRunbookID validates that the specified value is a AutomationRunbook ID
+func ValidateAutomationRunbookID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAutomationRunbookID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AutomationRunbook ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AutoscaleSettingID is a parsed Resource ID for a AutoscaleSetting
+type AutoscaleSettingID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAutoscaleSettingID returns a AutoscaleSettingID comprised of the specified components
+func NewAutoscaleSettingID(subscriptionId, resourceGroup, name string) AutoscaleSettingID {
+	return AutoscaleSettingID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this AutoscaleSetting
+func (id AutoscaleSettingID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/autoscalesettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAutoscaleSettingID parses the specified Resource ID into a AutoscaleSettingID
+func ParseAutoscaleSettingID(input string) (*AutoscaleSettingID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutoscaleSetting ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing %q as a AutoscaleSetting ID: expected the Resource Provider %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := AutoscaleSettingID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("autoscalesettings"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutoscaleSetting ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AutoscaleSetting ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAutoscaleSettingID validates that the specified value is a AutoscaleSetting ID
+func ValidateAutoscaleSettingID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAutoscaleSettingID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AutoscaleSetting ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// AvailabilitySetID is a parsed Resource ID for a AvailabilitySet
+type AvailabilitySetID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAvailabilitySetID returns a AvailabilitySetID comprised of the specified components
+func NewAvailabilitySetID(subscriptionId, resourceGroup, name string) AvailabilitySetID {
+	return AvailabilitySetID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this AvailabilitySet
+func (id AvailabilitySetID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAvailabilitySetID parses the specified Resource ID into a AvailabilitySetID
+func ParseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AvailabilitySet ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a AvailabilitySet ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := AvailabilitySetID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("availabilitySets"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AvailabilitySet ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a AvailabilitySet ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAvailabilitySetID validates that the specified value is a AvailabilitySet ID
+func ValidateAvailabilitySetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseAvailabilitySetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a AvailabilitySet ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CdnEndpointID is a parsed Resource ID for a CdnEndpoint
+type CdnEndpointID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ProfileName    string
+	Name           string
+}
+
+// NewCdnEndpointID returns a CdnEndpointID comprised of the specified components
+func NewCdnEndpointID(subscriptionId, resourceGroup, profileName, name string) CdnEndpointID {
+	return CdnEndpointID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ProfileName:    profileName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this CdnEndpoint
+func (id CdnEndpointID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/endpoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ProfileName, id.Name)
+}
+
+// ParseCdnEndpointID parses the specified Resource ID into a CdnEndpointID
+func ParseCdnEndpointID(input string) (*CdnEndpointID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CdnEndpoint ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cdn") {
+		return nil, fmt.Errorf("Error parsing %q as a CdnEndpoint ID: expected the Resource Provider %q but got %q", input, "Microsoft.Cdn", id.Provider)
+	}
+
+	resourceId := CdnEndpointID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CdnEndpoint ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("endpoints"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CdnEndpoint ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CdnEndpoint ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCdnEndpointID validates that the specified value is a CdnEndpoint ID
+func ValidateCdnEndpointID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseCdnEndpointID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a CdnEndpoint ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
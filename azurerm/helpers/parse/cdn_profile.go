@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CdnProfileID is a parsed Resource ID for a CdnProfile
+type CdnProfileID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewCdnProfileID returns a CdnProfileID comprised of the specified components
+func NewCdnProfileID(subscriptionId, resourceGroup, name string) CdnProfileID {
+	return CdnProfileID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this CdnProfile
+func (id CdnProfileID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseCdnProfileID parses the specified Resource ID into a CdnProfileID
+func ParseCdnProfileID(input string) (*CdnProfileID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CdnProfile ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cdn") {
+		return nil, fmt.Errorf("Error parsing %q as a CdnProfile ID: expected the Resource Provider %q but got %q", input, "Microsoft.Cdn", id.Provider)
+	}
+
+	resourceId := CdnProfileID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("profiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CdnProfile ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CdnProfile ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCdnProfileID validates that the specified value is a CdnProfile ID
+func ValidateCdnProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseCdnProfileID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a CdnProfile ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
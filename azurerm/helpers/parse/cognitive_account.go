@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CognitiveAccountID is a parsed Resource ID for a CognitiveAccount
+type CognitiveAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewCognitiveAccountID returns a CognitiveAccountID comprised of the specified components
+func NewCognitiveAccountID(subscriptionId, resourceGroup, name string) CognitiveAccountID {
+	return CognitiveAccountID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this CognitiveAccount
+func (id CognitiveAccountID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CognitiveServices/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseCognitiveAccountID parses the specified Resource ID into a CognitiveAccountID
+func ParseCognitiveAccountID(input string) (*CognitiveAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CognitiveAccount ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.CognitiveServices") {
+		return nil, fmt.Errorf("Error parsing %q as a CognitiveAccount ID: expected the Resource Provider %q but got %q", input, "Microsoft.CognitiveServices", id.Provider)
+	}
+
+	resourceId := CognitiveAccountID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CognitiveAccount ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CognitiveAccount ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCognitiveAccountID validates that the specified value is a CognitiveAccount ID
+func ValidateCognitiveAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseCognitiveAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a CognitiveAccount ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
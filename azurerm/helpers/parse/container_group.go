@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ContainerGroupID is a parsed Resource ID for a ContainerGroup
+type ContainerGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewContainerGroupID returns a ContainerGroupID comprised of the specified components
+func NewContainerGroupID(subscriptionId, resourceGroup, name string) ContainerGroupID {
+	return ContainerGroupID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ContainerGroup
+func (id ContainerGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerInstance/containerGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseContainerGroupID parses the specified Resource ID into a ContainerGroupID
+func ParseContainerGroupID(input string) (*ContainerGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerGroup ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerInstance") {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerGroup ID: expected the Resource Provider %q but got %q", input, "Microsoft.ContainerInstance", id.Provider)
+	}
+
+	resourceId := ContainerGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("containerGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerGroup ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerGroup ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateContainerGroupID validates that the specified value is a ContainerGroup ID
+func ValidateContainerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseContainerGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ContainerGroup ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
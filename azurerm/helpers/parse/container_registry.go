@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ContainerRegistryID is a parsed Resource ID for a ContainerRegistry
+type ContainerRegistryID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewContainerRegistryID returns a ContainerRegistryID comprised of the specified components
+func NewContainerRegistryID(subscriptionId, resourceGroup, name string) ContainerRegistryID {
+	return ContainerRegistryID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ContainerRegistry
+func (id ContainerRegistryID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseContainerRegistryID parses the specified Resource ID into a ContainerRegistryID
+func ParseContainerRegistryID(input string) (*ContainerRegistryID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerRegistry ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerRegistry") {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerRegistry ID: expected the Resource Provider %q but got %q", input, "Microsoft.ContainerRegistry", id.Provider)
+	}
+
+	resourceId := ContainerRegistryID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("registries"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerRegistry ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ContainerRegistry ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateContainerRegistryID validates that the specified value is a ContainerRegistry ID
+func ValidateContainerRegistryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseContainerRegistryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ContainerRegistry ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
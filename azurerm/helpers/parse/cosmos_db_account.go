@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// CosmosDBAccountID is a parsed Resource ID for a CosmosDBAccount
+type CosmosDBAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewCosmosDBAccountID returns a CosmosDBAccountID comprised of the specified components
+func NewCosmosDBAccountID(subscriptionId, resourceGroup, name string) CosmosDBAccountID {
+	return CosmosDBAccountID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this CosmosDBAccount
+func (id CosmosDBAccountID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseCosmosDBAccountID parses the specified Resource ID into a CosmosDBAccountID
+func ParseCosmosDBAccountID(input string) (*CosmosDBAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CosmosDBAccount ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DocumentDB") {
+		return nil, fmt.Errorf("Error parsing %q as a CosmosDBAccount ID: expected the Resource Provider %q but got %q", input, "Microsoft.DocumentDB", id.Provider)
+	}
+
+	resourceId := CosmosDBAccountID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CosmosDBAccount ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CosmosDBAccount ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCosmosDBAccountID validates that the specified value is a CosmosDBAccount ID
+func ValidateCosmosDBAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseCosmosDBAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a CosmosDBAccount ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeAnalyticsAccountID is a parsed Resource ID for a DataLakeAnalyticsAccount
+type DataLakeAnalyticsAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDataLakeAnalyticsAccountID returns a DataLakeAnalyticsAccountID comprised of the specified components
+func NewDataLakeAnalyticsAccountID(subscriptionId, resourceGroup, name string) DataLakeAnalyticsAccountID {
+	return DataLakeAnalyticsAccountID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DataLakeAnalyticsAccount
+func (id DataLakeAnalyticsAccountID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeAnalytics/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseDataLakeAnalyticsAccountID parses the specified Resource ID into a DataLakeAnalyticsAccountID
+func ParseDataLakeAnalyticsAccountID(input string) (*DataLakeAnalyticsAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsAccount ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeAnalytics") {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsAccount ID: expected the Resource Provider %q but got %q", input, "Microsoft.DataLakeAnalytics", id.Provider)
+	}
+
+	resourceId := DataLakeAnalyticsAccountID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsAccount ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsAccount ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeAnalyticsAccountID validates that the specified value is a DataLakeAnalyticsAccount ID
+func ValidateDataLakeAnalyticsAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDataLakeAnalyticsAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DataLakeAnalyticsAccount ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
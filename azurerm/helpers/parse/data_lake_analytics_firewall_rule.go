@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeAnalyticsFirewallRuleID is a parsed Resource ID for a DataLakeAnalyticsFirewallRule
+type DataLakeAnalyticsFirewallRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewDataLakeAnalyticsFirewallRuleID returns a DataLakeAnalyticsFirewallRuleID comprised of the specified components
+func NewDataLakeAnalyticsFirewallRuleID(subscriptionId, resourceGroup, accountName, name string) DataLakeAnalyticsFirewallRuleID {
+	return DataLakeAnalyticsFirewallRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DataLakeAnalyticsFirewallRule
+func (id DataLakeAnalyticsFirewallRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeAnalytics/accounts/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseDataLakeAnalyticsFirewallRuleID parses the specified Resource ID into a DataLakeAnalyticsFirewallRuleID
+func ParseDataLakeAnalyticsFirewallRuleID(input string) (*DataLakeAnalyticsFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsFirewallRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeAnalytics") {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsFirewallRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.DataLakeAnalytics", id.Provider)
+	}
+
+	resourceId := DataLakeAnalyticsFirewallRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsFirewallRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsFirewallRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeAnalyticsFirewallRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeAnalyticsFirewallRuleID validates that the specified value is a DataLakeAnalyticsFirewallRule ID
+func ValidateDataLakeAnalyticsFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDataLakeAnalyticsFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DataLakeAnalyticsFirewallRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeStoreID is a parsed Resource ID for a DataLakeStore
+type DataLakeStoreID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDataLakeStoreID returns a DataLakeStoreID comprised of the specified components
+func NewDataLakeStoreID(subscriptionId, resourceGroup, name string) DataLakeStoreID {
+	return DataLakeStoreID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DataLakeStore
+func (id DataLakeStoreID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeStore/accounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseDataLakeStoreID parses the specified Resource ID into a DataLakeStoreID
+func ParseDataLakeStoreID(input string) (*DataLakeStoreID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStore ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeStore") {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStore ID: expected the Resource Provider %q but got %q", input, "Microsoft.DataLakeStore", id.Provider)
+	}
+
+	resourceId := DataLakeStoreID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStore ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStore ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeStoreID validates that the specified value is a DataLakeStore ID
+func ValidateDataLakeStoreID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDataLakeStoreID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DataLakeStore ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DataLakeStoreFirewallRuleID is a parsed Resource ID for a DataLakeStoreFirewallRule
+type DataLakeStoreFirewallRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewDataLakeStoreFirewallRuleID returns a DataLakeStoreFirewallRuleID comprised of the specified components
+func NewDataLakeStoreFirewallRuleID(subscriptionId, resourceGroup, accountName, name string) DataLakeStoreFirewallRuleID {
+	return DataLakeStoreFirewallRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DataLakeStoreFirewallRule
+func (id DataLakeStoreFirewallRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataLakeStore/accounts/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseDataLakeStoreFirewallRuleID parses the specified Resource ID into a DataLakeStoreFirewallRuleID
+func ParseDataLakeStoreFirewallRuleID(input string) (*DataLakeStoreFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStoreFirewallRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DataLakeStore") {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStoreFirewallRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.DataLakeStore", id.Provider)
+	}
+
+	resourceId := DataLakeStoreFirewallRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStoreFirewallRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStoreFirewallRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DataLakeStoreFirewallRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDataLakeStoreFirewallRuleID validates that the specified value is a DataLakeStoreFirewallRule ID
+func ValidateDataLakeStoreFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDataLakeStoreFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DataLakeStoreFirewallRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DatabricksWorkspaceID is a parsed Resource ID for a DatabricksWorkspace
+type DatabricksWorkspaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDatabricksWorkspaceID returns a DatabricksWorkspaceID comprised of the specified components
+func NewDatabricksWorkspaceID(subscriptionId, resourceGroup, name string) DatabricksWorkspaceID {
+	return DatabricksWorkspaceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DatabricksWorkspace
+func (id DatabricksWorkspaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Databricks/workspaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseDatabricksWorkspaceID parses the specified Resource ID into a DatabricksWorkspaceID
+func ParseDatabricksWorkspaceID(input string) (*DatabricksWorkspaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DatabricksWorkspace ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Databricks") {
+		return nil, fmt.Errorf("Error parsing %q as a DatabricksWorkspace ID: expected the Resource Provider %q but got %q", input, "Microsoft.Databricks", id.Provider)
+	}
+
+	resourceId := DatabricksWorkspaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("workspaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DatabricksWorkspace ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DatabricksWorkspace ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDatabricksWorkspaceID validates that the specified value is a DatabricksWorkspace ID
+func ValidateDatabricksWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDatabricksWorkspaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DatabricksWorkspace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
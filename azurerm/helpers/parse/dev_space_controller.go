@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevSpaceControllerID is a parsed Resource ID for a DevSpaceController
+type DevSpaceControllerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDevSpaceControllerID returns a DevSpaceControllerID comprised of the specified components
+func NewDevSpaceControllerID(subscriptionId, resourceGroup, name string) DevSpaceControllerID {
+	return DevSpaceControllerID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DevSpaceController
+func (id DevSpaceControllerID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevSpaces/controllers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseDevSpaceControllerID parses the specified Resource ID into a DevSpaceControllerID
+func ParseDevSpaceControllerID(input string) (*DevSpaceControllerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevSpaceController ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevSpaces") {
+		return nil, fmt.Errorf("Error parsing %q as a DevSpaceController ID: expected the Resource Provider %q but got %q", input, "Microsoft.DevSpaces", id.Provider)
+	}
+
+	resourceId := DevSpaceControllerID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("controllers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevSpaceController ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevSpaceController ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevSpaceControllerID validates that the specified value is a DevSpaceController ID
+func ValidateDevSpaceControllerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDevSpaceControllerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DevSpaceController ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestLabID is a parsed Resource ID for a DevTestLab
+type DevTestLabID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDevTestLabID returns a DevTestLabID comprised of the specified components
+func NewDevTestLabID(subscriptionId, resourceGroup, name string) DevTestLabID {
+	return DevTestLabID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DevTestLab
+func (id DevTestLabID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseDevTestLabID parses the specified Resource ID into a DevTestLabID
+func ParseDevTestLabID(input string) (*DevTestLabID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestLab ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestLab ID: expected the Resource Provider %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestLabID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestLab ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestLab ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestLabID validates that the specified value is a DevTestLab ID
+func ValidateDevTestLabID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDevTestLabID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DevTestLab ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestPolicyID is a parsed Resource ID for a DevTestPolicy
+type DevTestPolicyID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	LabName        string
+	PolicySetName  string
+	Name           string
+}
+
+// NewDevTestPolicyID returns a DevTestPolicyID comprised of the specified components
+func NewDevTestPolicyID(subscriptionId, resourceGroup, labName, policySetName, name string) DevTestPolicyID {
+	return DevTestPolicyID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		PolicySetName:  policySetName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DevTestPolicy
+func (id DevTestPolicyID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/policysets/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LabName, id.PolicySetName, id.Name)
+}
+
+// ParseDevTestPolicyID parses the specified Resource ID into a DevTestPolicyID
+func ParseDevTestPolicyID(input string) (*DevTestPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestPolicy ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestPolicy ID: expected the Resource Provider %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestPolicyID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestPolicy ID: %+v", input, err)
+	}
+
+	if resourceId.PolicySetName, err = id.PopSegment("policysets"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestPolicy ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("policies"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestPolicy ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestPolicy ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestPolicyID validates that the specified value is a DevTestPolicy ID
+func ValidateDevTestPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDevTestPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DevTestPolicy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
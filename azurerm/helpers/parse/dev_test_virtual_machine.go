@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestVirtualMachineID is a parsed Resource ID for a DevTestVirtualMachine
+type DevTestVirtualMachineID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	LabName        string
+	Name           string
+}
+
+// NewDevTestVirtualMachineID returns a DevTestVirtualMachineID comprised of the specified components
+func NewDevTestVirtualMachineID(subscriptionId, resourceGroup, labName, name string) DevTestVirtualMachineID {
+	return DevTestVirtualMachineID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DevTestVirtualMachine
+func (id DevTestVirtualMachineID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/virtualmachines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LabName, id.Name)
+}
+
+// ParseDevTestVirtualMachineID parses the specified Resource ID into a DevTestVirtualMachineID
+func ParseDevTestVirtualMachineID(input string) (*DevTestVirtualMachineID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualMachine ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualMachine ID: expected the Resource Provider %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestVirtualMachineID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualMachine ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualmachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualMachine ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualMachine ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestVirtualMachineID validates that the specified value is a DevTestVirtualMachine ID
+func ValidateDevTestVirtualMachineID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDevTestVirtualMachineID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DevTestVirtualMachine ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
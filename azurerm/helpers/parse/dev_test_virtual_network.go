@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DevTestVirtualNetworkID is a parsed Resource ID for a DevTestVirtualNetwork
+type DevTestVirtualNetworkID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	LabName        string
+	Name           string
+}
+
+// NewDevTestVirtualNetworkID returns a DevTestVirtualNetworkID comprised of the specified components
+func NewDevTestVirtualNetworkID(subscriptionId, resourceGroup, labName, name string) DevTestVirtualNetworkID {
+	return DevTestVirtualNetworkID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DevTestVirtualNetwork
+func (id DevTestVirtualNetworkID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/virtualnetworks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LabName, id.Name)
+}
+
+// ParseDevTestVirtualNetworkID parses the specified Resource ID into a DevTestVirtualNetworkID
+func ParseDevTestVirtualNetworkID(input string) (*DevTestVirtualNetworkID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualNetwork ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DevTestLab") {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualNetwork ID: expected the Resource Provider %q but got %q", input, "Microsoft.DevTestLab", id.Provider)
+	}
+
+	resourceId := DevTestVirtualNetworkID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualNetwork ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualnetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualNetwork ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTestVirtualNetwork ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDevTestVirtualNetworkID validates that the specified value is a DevTestVirtualNetwork ID
+func ValidateDevTestVirtualNetworkID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDevTestVirtualNetworkID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DevTestVirtualNetwork ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsARecordID is a parsed Resource ID for a DnsARecord
+type DnsARecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsARecordID returns a DnsARecordID comprised of the specified components
+func NewDnsARecordID(subscriptionId, resourceGroup, zoneName, name string) DnsARecordID {
+	return DnsARecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsARecord
+func (id DnsARecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/A/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsARecordID parses the specified Resource ID into a DnsARecordID
+func ParseDnsARecordID(input string) (*DnsARecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsARecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsARecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsARecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsARecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("A"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsARecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsARecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsARecordID validates that the specified value is a DnsARecord ID
+func ValidateDnsARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsARecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
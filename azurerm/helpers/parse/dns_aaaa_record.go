@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsAAAARecordID is a parsed Resource ID for a DnsAAAARecord
+type DnsAAAARecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsAAAARecordID returns a DnsAAAARecordID comprised of the specified components
+func NewDnsAAAARecordID(subscriptionId, resourceGroup, zoneName, name string) DnsAAAARecordID {
+	return DnsAAAARecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsAAAARecord
+func (id DnsAAAARecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/AAAA/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsAAAARecordID parses the specified Resource ID into a DnsAAAARecordID
+func ParseDnsAAAARecordID(input string) (*DnsAAAARecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsAAAARecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsAAAARecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsAAAARecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsAAAARecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("AAAA"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsAAAARecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsAAAARecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsAAAARecordID validates that the specified value is a DnsAAAARecord ID
+func ValidateDnsAAAARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsAAAARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsAAAARecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
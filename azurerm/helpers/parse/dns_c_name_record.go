@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsCNameRecordID is a parsed Resource ID for a DnsCNameRecord
+type DnsCNameRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsCNameRecordID returns a DnsCNameRecordID comprised of the specified components
+func NewDnsCNameRecordID(subscriptionId, resourceGroup, zoneName, name string) DnsCNameRecordID {
+	return DnsCNameRecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsCNameRecord
+func (id DnsCNameRecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CNAME/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsCNameRecordID parses the specified Resource ID into a DnsCNameRecordID
+func ParseDnsCNameRecordID(input string) (*DnsCNameRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCNameRecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCNameRecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsCNameRecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCNameRecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("CNAME"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCNameRecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCNameRecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsCNameRecordID validates that the specified value is a DnsCNameRecord ID
+func ValidateDnsCNameRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsCNameRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsCNameRecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsCaaRecordID is a parsed Resource ID for a DnsCaaRecord
+type DnsCaaRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsCaaRecordID returns a DnsCaaRecordID comprised of the specified components
+func NewDnsCaaRecordID(subscriptionId, resourceGroup, zoneName, name string) DnsCaaRecordID {
+	return DnsCaaRecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsCaaRecord
+func (id DnsCaaRecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CAA/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsCaaRecordID parses the specified Resource ID into a DnsCaaRecordID
+func ParseDnsCaaRecordID(input string) (*DnsCaaRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCaaRecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCaaRecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsCaaRecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCaaRecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("CAA"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCaaRecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsCaaRecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsCaaRecordID validates that the specified value is a DnsCaaRecord ID
+func ValidateDnsCaaRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsCaaRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsCaaRecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsMxRecordID is a parsed Resource ID for a DnsMxRecord
+type DnsMxRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsMxRecordID returns a DnsMxRecordID comprised of the specified components
+func NewDnsMxRecordID(subscriptionId, resourceGroup, zoneName, name string) DnsMxRecordID {
+	return DnsMxRecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsMxRecord
+func (id DnsMxRecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/MX/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsMxRecordID parses the specified Resource ID into a DnsMxRecordID
+func ParseDnsMxRecordID(input string) (*DnsMxRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsMxRecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsMxRecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsMxRecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsMxRecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("MX"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsMxRecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsMxRecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsMxRecordID validates that the specified value is a DnsMxRecord ID
+func ValidateDnsMxRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsMxRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsMxRecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
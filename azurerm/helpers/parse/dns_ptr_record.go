@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsPtrRecordID is a parsed Resource ID for a DnsPtrRecord
+type DnsPtrRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsPtrRecordID returns a DnsPtrRecordID comprised of the specified components
+func NewDnsPtrRecordID(subscriptionId, resourceGroup, zoneName, name string) DnsPtrRecordID {
+	return DnsPtrRecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsPtrRecord
+func (id DnsPtrRecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/PTR/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsPtrRecordID parses the specified Resource ID into a DnsPtrRecordID
+func ParseDnsPtrRecordID(input string) (*DnsPtrRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsPtrRecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsPtrRecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsPtrRecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsPtrRecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("PTR"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsPtrRecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsPtrRecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsPtrRecordID validates that the specified value is a DnsPtrRecord ID
+func ValidateDnsPtrRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsPtrRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsPtrRecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsSrvRecordID is a parsed Resource ID for a DnsSrvRecord
+type DnsSrvRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsSrvRecordID returns a DnsSrvRecordID comprised of the specified components
+func NewDnsSrvRecordID(subscriptionId, resourceGroup, zoneName, name string) DnsSrvRecordID {
+	return DnsSrvRecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsSrvRecord
+func (id DnsSrvRecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/SRV/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsSrvRecordID parses the specified Resource ID into a DnsSrvRecordID
+func ParseDnsSrvRecordID(input string) (*DnsSrvRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsSrvRecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsSrvRecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsSrvRecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsSrvRecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("SRV"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsSrvRecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsSrvRecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsSrvRecordID validates that the specified value is a DnsSrvRecord ID
+func ValidateDnsSrvRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsSrvRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsSrvRecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
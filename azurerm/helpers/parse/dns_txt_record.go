@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// DnsTxtRecordID is a parsed Resource ID for a DnsTxtRecord
+type DnsTxtRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewDnsTxtRecordID returns a DnsTxtRecordID comprised of the specified components
+func NewDnsTxtRecordID(subscriptionId, resourceGroup, zoneName, name string) DnsTxtRecordID {
+	return DnsTxtRecordID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this DnsTxtRecord
+func (id DnsTxtRecordID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/TXT/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParseDnsTxtRecordID parses the specified Resource ID into a DnsTxtRecordID
+func ParseDnsTxtRecordID(input string) (*DnsTxtRecordID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsTxtRecord ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a DnsTxtRecord ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsTxtRecordID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ZoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsTxtRecord ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("TXT"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsTxtRecord ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DnsTxtRecord ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsTxtRecordID validates that the specified value is a DnsTxtRecord ID
+func ValidateDnsTxtRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseDnsTxtRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a DnsTxtRecord ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventGridTopicID is a parsed Resource ID for a EventGridTopic
+type EventGridTopicID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewEventGridTopicID returns a EventGridTopicID comprised of the specified components
+func NewEventGridTopicID(subscriptionId, resourceGroup, name string) EventGridTopicID {
+	return EventGridTopicID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this EventGridTopic
+func (id EventGridTopicID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/topics/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseEventGridTopicID parses the specified Resource ID into a EventGridTopicID
+func ParseEventGridTopicID(input string) (*EventGridTopicID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventGridTopic ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventGrid") {
+		return nil, fmt.Errorf("Error parsing %q as a EventGridTopic ID: expected the Resource Provider %q but got %q", input, "Microsoft.EventGrid", id.Provider)
+	}
+
+	resourceId := EventGridTopicID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventGridTopic ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventGridTopic ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventGridTopicID validates that the specified value is a EventGridTopic ID
+func ValidateEventGridTopicID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseEventGridTopicID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a EventGridTopic ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubID is a parsed Resource ID for a EventHub
+type EventHubID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewEventHubID returns a EventHubID comprised of the specified components
+func NewEventHubID(subscriptionId, resourceGroup, namespaceName, name string) EventHubID {
+	return EventHubID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this EventHub
+func (id EventHubID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseEventHubID parses the specified Resource ID into a EventHubID
+func ParseEventHubID(input string) (*EventHubID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHub ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing %q as a EventHub ID: expected the Resource Provider %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHub ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHub ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHub ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubID validates that the specified value is a EventHub ID
+func ValidateEventHubID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseEventHubID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a EventHub ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubAuthorizationRuleID is a parsed Resource ID for a EventHubAuthorizationRule
+type EventHubAuthorizationRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	EventHubName   string
+	Name           string
+}
+
+// NewEventHubAuthorizationRuleID returns a EventHubAuthorizationRuleID comprised of the specified components
+func NewEventHubAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, eventHubName, name string) EventHubAuthorizationRuleID {
+	return EventHubAuthorizationRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		EventHubName:   eventHubName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this EventHubAuthorizationRule
+func (id EventHubAuthorizationRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
+
+// ParseEventHubAuthorizationRuleID parses the specified Resource ID into a EventHubAuthorizationRuleID
+func ParseEventHubAuthorizationRuleID(input string) (*EventHubAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubAuthorizationRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubAuthorizationRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.EventHubName, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubAuthorizationRuleID validates that the specified value is a EventHubAuthorizationRule ID
+func ValidateEventHubAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseEventHubAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a EventHubAuthorizationRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
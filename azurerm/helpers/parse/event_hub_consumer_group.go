@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubConsumerGroupID is a parsed Resource ID for a EventHubConsumerGroup
+type EventHubConsumerGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	EventHubName   string
+	Name           string
+}
+
+// NewEventHubConsumerGroupID returns a EventHubConsumerGroupID comprised of the specified components
+func NewEventHubConsumerGroupID(subscriptionId, resourceGroup, namespaceName, eventHubName, name string) EventHubConsumerGroupID {
+	return EventHubConsumerGroupID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		EventHubName:   eventHubName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this EventHubConsumerGroup
+func (id EventHubConsumerGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/consumergroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
+
+// ParseEventHubConsumerGroupID parses the specified Resource ID into a EventHubConsumerGroupID
+func ParseEventHubConsumerGroupID(input string) (*EventHubConsumerGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubConsumerGroup ID: expected the Resource Provider %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubConsumerGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if resourceId.EventHubName, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("consumergroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubConsumerGroup ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubConsumerGroupID validates that the specified value is a EventHubConsumerGroup ID
+func ValidateEventHubConsumerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseEventHubConsumerGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a EventHubConsumerGroup ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubNamespaceID is a parsed Resource ID for a EventHubNamespace
+type EventHubNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewEventHubNamespaceID returns a EventHubNamespaceID comprised of the specified components
+func NewEventHubNamespaceID(subscriptionId, resourceGroup, name string) EventHubNamespaceID {
+	return EventHubNamespaceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this EventHubNamespace
+func (id EventHubNamespaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseEventHubNamespaceID parses the specified Resource ID into a EventHubNamespaceID
+func ParseEventHubNamespaceID(input string) (*EventHubNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespace ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespace ID: expected the Resource Provider %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubNamespaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespace ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespace ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubNamespaceID validates that the specified value is a EventHubNamespace ID
+func ValidateEventHubNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseEventHubNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a EventHubNamespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
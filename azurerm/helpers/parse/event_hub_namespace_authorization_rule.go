@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// EventHubNamespaceAuthorizationRuleID is a parsed Resource ID for a EventHubNamespaceAuthorizationRule
+type EventHubNamespaceAuthorizationRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewEventHubNamespaceAuthorizationRuleID returns a EventHubNamespaceAuthorizationRuleID comprised of the specified components
+func NewEventHubNamespaceAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, name string) EventHubNamespaceAuthorizationRuleID {
+	return EventHubNamespaceAuthorizationRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this EventHubNamespaceAuthorizationRule
+func (id EventHubNamespaceAuthorizationRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseEventHubNamespaceAuthorizationRuleID parses the specified Resource ID into a EventHubNamespaceAuthorizationRuleID
+func ParseEventHubNamespaceAuthorizationRuleID(input string) (*EventHubNamespaceAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.EventHub") {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespaceAuthorizationRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.EventHub", id.Provider)
+	}
+
+	resourceId := EventHubNamespaceAuthorizationRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a EventHubNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateEventHubNamespaceAuthorizationRuleID validates that the specified value is a EventHubNamespaceAuthorizationRule ID
+func ValidateEventHubNamespaceAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseEventHubNamespaceAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a EventHubNamespaceAuthorizationRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitID is a parsed Resource ID for a ExpressRouteCircuit
+type ExpressRouteCircuitID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewExpressRouteCircuitID returns a ExpressRouteCircuitID comprised of the specified components
+func NewExpressRouteCircuitID(subscriptionId, resourceGroup, name string) ExpressRouteCircuitID {
+	return ExpressRouteCircuitID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ExpressRouteCircuit
+func (id ExpressRouteCircuitID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseExpressRouteCircuitID parses the specified Resource ID into a ExpressRouteCircuitID
+func ParseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuit ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuit ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ExpressRouteCircuitID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuit ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuit ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitID validates that the specified value is a ExpressRouteCircuit ID
+func ValidateExpressRouteCircuitID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRouteCircuitID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ExpressRouteCircuit ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
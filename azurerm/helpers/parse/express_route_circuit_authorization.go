@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitAuthorizationID is a parsed Resource ID for a ExpressRouteCircuitAuthorization
+type ExpressRouteCircuitAuthorizationID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	CircuitName    string
+	Name           string
+}
+
+// NewExpressRouteCircuitAuthorizationID returns a ExpressRouteCircuitAuthorizationID comprised of the specified components
+func NewExpressRouteCircuitAuthorizationID(subscriptionId, resourceGroup, circuitName, name string) ExpressRouteCircuitAuthorizationID {
+	return ExpressRouteCircuitAuthorizationID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		CircuitName:    circuitName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ExpressRouteCircuitAuthorization
+func (id ExpressRouteCircuitAuthorizationID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s/authorizations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.CircuitName, id.Name)
+}
+
+// ParseExpressRouteCircuitAuthorizationID parses the specified Resource ID into a ExpressRouteCircuitAuthorizationID
+func ParseExpressRouteCircuitAuthorizationID(input string) (*ExpressRouteCircuitAuthorizationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitAuthorization ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitAuthorization ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ExpressRouteCircuitAuthorizationID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.CircuitName, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitAuthorization ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizations"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitAuthorization ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitAuthorization ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitAuthorizationID validates that the specified value is a ExpressRouteCircuitAuthorization ID
+func ValidateExpressRouteCircuitAuthorizationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRouteCircuitAuthorizationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ExpressRouteCircuitAuthorization ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
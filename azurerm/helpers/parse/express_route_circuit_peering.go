@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ExpressRouteCircuitPeeringID is a parsed Resource ID for a ExpressRouteCircuitPeering
+type ExpressRouteCircuitPeeringID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	CircuitName    string
+	Name           string
+}
+
+// NewExpressRouteCircuitPeeringID returns a ExpressRouteCircuitPeeringID comprised of the specified components
+func NewExpressRouteCircuitPeeringID(subscriptionId, resourceGroup, circuitName, name string) ExpressRouteCircuitPeeringID {
+	return ExpressRouteCircuitPeeringID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		CircuitName:    circuitName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ExpressRouteCircuitPeering
+func (id ExpressRouteCircuitPeeringID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/expressRouteCircuits/%s/peerings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.CircuitName, id.Name)
+}
+
+// ParseExpressRouteCircuitPeeringID parses the specified Resource ID into a ExpressRouteCircuitPeeringID
+func ParseExpressRouteCircuitPeeringID(input string) (*ExpressRouteCircuitPeeringID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitPeering ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitPeering ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ExpressRouteCircuitPeeringID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.CircuitName, err = id.PopSegment("expressRouteCircuits"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitPeering ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("peerings"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitPeering ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ExpressRouteCircuitPeering ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateExpressRouteCircuitPeeringID validates that the specified value is a ExpressRouteCircuitPeering ID
+func ValidateExpressRouteCircuitPeeringID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseExpressRouteCircuitPeeringID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ExpressRouteCircuitPeering ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
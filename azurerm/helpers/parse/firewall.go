@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallID is a parsed Resource ID for a Firewall
+type FirewallID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFirewallID returns a FirewallID comprised of the specified components
+func NewFirewallID(subscriptionId, resourceGroup, name string) FirewallID {
+	return FirewallID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this Firewall
+func (id FirewallID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseFirewallID parses the specified Resource ID into a FirewallID
+func ParseFirewallID(input string) (*FirewallID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Firewall ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a Firewall ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Firewall ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Firewall ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallID validates that the specified value is a Firewall ID
+func ValidateFirewallID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Firewall ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
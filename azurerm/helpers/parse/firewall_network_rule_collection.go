@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FirewallNetworkRuleCollectionID is a parsed Resource ID for a FirewallNetworkRuleCollection
+type FirewallNetworkRuleCollectionID struct {
+	SubscriptionID    string
+	ResourceGroup     string
+	AzureFirewallName string
+	Name              string
+}
+
+// NewFirewallNetworkRuleCollectionID returns a FirewallNetworkRuleCollectionID comprised of the specified components
+func NewFirewallNetworkRuleCollectionID(subscriptionId, resourceGroup, azureFirewallName, name string) FirewallNetworkRuleCollectionID {
+	return FirewallNetworkRuleCollectionID{
+		SubscriptionID:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		AzureFirewallName: azureFirewallName,
+		Name:              name,
+	}
+}
+
+// ID returns the Resource ID for this FirewallNetworkRuleCollection
+func (id FirewallNetworkRuleCollectionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/networkRuleCollections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.AzureFirewallName, id.Name)
+}
+
+// ParseFirewallNetworkRuleCollectionID parses the specified Resource ID into a FirewallNetworkRuleCollectionID
+func ParseFirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a FirewallNetworkRuleCollection ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a FirewallNetworkRuleCollection ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := FirewallNetworkRuleCollectionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a FirewallNetworkRuleCollection ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkRuleCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a FirewallNetworkRuleCollection ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a FirewallNetworkRuleCollection ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFirewallNetworkRuleCollectionID validates that the specified value is a FirewallNetworkRuleCollection ID
+func ValidateFirewallNetworkRuleCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFirewallNetworkRuleCollectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a FirewallNetworkRuleCollection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// FunctionAppID is a parsed Resource ID for a FunctionApp
+type FunctionAppID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFunctionAppID returns a FunctionAppID comprised of the specified components
+func NewFunctionAppID(subscriptionId, resourceGroup, name string) FunctionAppID {
+	return FunctionAppID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this FunctionApp
+func (id FunctionAppID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseFunctionAppID parses the specified Resource ID into a FunctionAppID
+func ParseFunctionAppID(input string) (*FunctionAppID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a FunctionApp ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("Error parsing %q as a FunctionApp ID: expected the Resource Provider %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := FunctionAppID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("sites"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a FunctionApp ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a FunctionApp ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateFunctionAppID validates that the specified value is a FunctionApp ID
+func ValidateFunctionAppID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseFunctionAppID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a FunctionApp ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// IotHubID is a parsed Resource ID for a IotHub
+type IotHubID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewIotHubID returns a IotHubID comprised of the specified components
+func NewIotHubID(subscriptionId, resourceGroup, name string) IotHubID {
+	return IotHubID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this IotHub
+func (id IotHubID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseIotHubID parses the specified Resource ID into a IotHubID
+func ParseIotHubID(input string) (*IotHubID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHub ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Devices") {
+		return nil, fmt.Errorf("Error parsing %q as a IotHub ID: expected the Resource Provider %q but got %q", input, "Microsoft.Devices", id.Provider)
+	}
+
+	resourceId := IotHubID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("IotHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHub ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHub ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateIotHubID validates that the specified value is a IotHub ID
+func ValidateIotHubID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseIotHubID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a IotHub ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// IotHubConsumerGroupID is a parsed Resource ID for a IotHubConsumerGroup
+type IotHubConsumerGroupID struct {
+	SubscriptionID       string
+	ResourceGroup        string
+	IotHubName           string
+	EventHubEndpointName string
+	Name                 string
+}
+
+// NewIotHubConsumerGroupID returns a IotHubConsumerGroupID comprised of the specified components
+func NewIotHubConsumerGroupID(subscriptionId, resourceGroup, iotHubName, eventHubEndpointName, name string) IotHubConsumerGroupID {
+	return IotHubConsumerGroupID{
+		SubscriptionID:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		IotHubName:           iotHubName,
+		EventHubEndpointName: eventHubEndpointName,
+		Name:                 name,
+	}
+}
+
+// ID returns the Resource ID for this IotHubConsumerGroup
+func (id IotHubConsumerGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s/eventHubEndpoints/%s/ConsumerGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
+}
+
+// ParseIotHubConsumerGroupID parses the specified Resource ID into a IotHubConsumerGroupID
+func ParseIotHubConsumerGroupID(input string) (*IotHubConsumerGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Devices") {
+		return nil, fmt.Errorf("Error parsing %q as a IotHubConsumerGroup ID: expected the Resource Provider %q but got %q", input, "Microsoft.Devices", id.Provider)
+	}
+
+	resourceId := IotHubConsumerGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.IotHubName, err = id.PopSegment("IotHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if resourceId.EventHubEndpointName, err = id.PopSegment("eventHubEndpoints"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("ConsumerGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHubConsumerGroup ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a IotHubConsumerGroup ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateIotHubConsumerGroupID validates that the specified value is a IotHubConsumerGroup ID
+func ValidateIotHubConsumerGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseIotHubConsumerGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a IotHubConsumerGroup ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,137 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func TestIotHubConsumerGroupIDFormatter(t *testing.T) {
+	actual := NewIotHubConsumerGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "iotHub1", "eventHubEndpoint1", "iothubconsumergroup1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/iotHub1/eventHubEndpoints/eventHubEndpoint1/ConsumerGroups/iothubconsumergroup1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseIotHubConsumerGroupID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *IotHubConsumerGroupID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing SubscriptionID Value",
+			Input:    "/subscriptions/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ResourceGroup",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ResourceGroup Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Provider Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing IotHubName",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing IotHubName Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing EventHubEndpointName",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/iotHub1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing EventHubEndpointName Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/iotHub1/eventHubEndpoints/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/iotHub1/eventHubEndpoints/eventHubEndpoint1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/iotHub1/eventHubEndpoints/eventHubEndpoint1/ConsumerGroups/",
+			Expected: nil,
+		},
+		{
+			Name:  "IotHubConsumerGroup ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/iotHub1/eventHubEndpoints/eventHubEndpoint1/ConsumerGroups/iothubconsumergroup1",
+			Expected: &IotHubConsumerGroupID{
+				SubscriptionID:       "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:        "resGroup1",
+				IotHubName:           "iotHub1",
+				EventHubEndpointName: "eventHubEndpoint1",
+				Name:                 "iothubconsumergroup1",
+			},
+		},
+		{
+			Name:  "Upper-cased Segment Names",
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Devices/IOTHUBS/iotHub1/EVENTHUBENDPOINTS/eventHubEndpoint1/CONSUMERGROUPS/iothubconsumergroup1",
+			Expected: &IotHubConsumerGroupID{
+				SubscriptionID:       "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:        "resGroup1",
+				IotHubName:           "iotHub1",
+				EventHubEndpointName: "eventHubEndpoint1",
+				Name:                 "iothubconsumergroup1",
+			},
+		},
+		{
+			Name:     "Additional Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/iotHub1/eventHubEndpoints/eventHubEndpoint1/ConsumerGroups/iothubconsumergroup1/additional/segment1",
+			Expected: nil,
+		},
+		{
+			Name:     "Different Resource Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Different/IotHubs/iotHub1/eventHubEndpoints/eventHubEndpoint1/ConsumerGroups/iothubconsumergroup1",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseIotHubConsumerGroupID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value: %+v", *actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
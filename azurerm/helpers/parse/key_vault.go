@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// KeyVaultID is a parsed Resource ID for a KeyVault
+type KeyVaultID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewKeyVaultID returns a KeyVaultID comprised of the specified components
+func NewKeyVaultID(subscriptionId, resourceGroup, name string) KeyVaultID {
+	return KeyVaultID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this KeyVault
+func (id KeyVaultID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseKeyVaultID parses the specified Resource ID into a KeyVaultID
+func ParseKeyVaultID(input string) (*KeyVaultID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a KeyVault ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.KeyVault") {
+		return nil, fmt.Errorf("Error parsing %q as a KeyVault ID: expected the Resource Provider %q but got %q", input, "Microsoft.KeyVault", id.Provider)
+	}
+
+	resourceId := KeyVaultID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a KeyVault ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a KeyVault ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKeyVaultID validates that the specified value is a KeyVault ID
+func ValidateKeyVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseKeyVaultID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a KeyVault ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// KubernetesClusterID is a parsed Resource ID for a KubernetesCluster
+type KubernetesClusterID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewKubernetesClusterID returns a KubernetesClusterID comprised of the specified components
+func NewKubernetesClusterID(subscriptionId, resourceGroup, name string) KubernetesClusterID {
+	return KubernetesClusterID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this KubernetesCluster
+func (id KubernetesClusterID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseKubernetesClusterID parses the specified Resource ID into a KubernetesClusterID
+func ParseKubernetesClusterID(input string) (*KubernetesClusterID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a KubernetesCluster ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerService") {
+		return nil, fmt.Errorf("Error parsing %q as a KubernetesCluster ID: expected the Resource Provider %q but got %q", input, "Microsoft.ContainerService", id.Provider)
+	}
+
+	resourceId := KubernetesClusterID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a KubernetesCluster ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a KubernetesCluster ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKubernetesClusterID validates that the specified value is a KubernetesCluster ID
+func ValidateKubernetesClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseKubernetesClusterID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a KubernetesCluster ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
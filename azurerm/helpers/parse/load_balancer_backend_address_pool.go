@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerBackendAddressPoolID is a parsed Resource ID for a LoadBalancerBackendAddressPool
+type LoadBalancerBackendAddressPoolID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerBackendAddressPoolID returns a LoadBalancerBackendAddressPoolID comprised of the specified components
+func NewLoadBalancerBackendAddressPoolID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerBackendAddressPoolID {
+	return LoadBalancerBackendAddressPoolID{
+		SubscriptionID:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the Resource ID for this LoadBalancerBackendAddressPool
+func (id LoadBalancerBackendAddressPoolID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/backendAddressPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerBackendAddressPoolID parses the specified Resource ID into a LoadBalancerBackendAddressPoolID
+func ParseLoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerBackendAddressPool ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerBackendAddressPool ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerBackendAddressPoolID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerBackendAddressPool ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("backendAddressPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerBackendAddressPool ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerBackendAddressPool ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerBackendAddressPoolID validates that the specified value is a LoadBalancerBackendAddressPool ID
+func ValidateLoadBalancerBackendAddressPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerBackendAddressPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LoadBalancerBackendAddressPool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
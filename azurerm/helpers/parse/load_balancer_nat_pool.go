@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerNatPoolID is a parsed Resource ID for a LoadBalancerNatPool
+type LoadBalancerNatPoolID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerNatPoolID returns a LoadBalancerNatPoolID comprised of the specified components
+func NewLoadBalancerNatPoolID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerNatPoolID {
+	return LoadBalancerNatPoolID{
+		SubscriptionID:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the Resource ID for this LoadBalancerNatPool
+func (id LoadBalancerNatPoolID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerNatPoolID parses the specified Resource ID into a LoadBalancerNatPoolID
+func ParseLoadBalancerNatPoolID(input string) (*LoadBalancerNatPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatPool ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatPool ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerNatPoolID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatPool ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("inboundNatPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatPool ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatPool ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerNatPoolID validates that the specified value is a LoadBalancerNatPool ID
+func ValidateLoadBalancerNatPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerNatPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LoadBalancerNatPool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerNatRuleID is a parsed Resource ID for a LoadBalancerNatRule
+type LoadBalancerNatRuleID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerNatRuleID returns a LoadBalancerNatRuleID comprised of the specified components
+func NewLoadBalancerNatRuleID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerNatRuleID {
+	return LoadBalancerNatRuleID{
+		SubscriptionID:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the Resource ID for this LoadBalancerNatRule
+func (id LoadBalancerNatRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerNatRuleID parses the specified Resource ID into a LoadBalancerNatRuleID
+func ParseLoadBalancerNatRuleID(input string) (*LoadBalancerNatRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerNatRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("inboundNatRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerNatRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerNatRuleID validates that the specified value is a LoadBalancerNatRule ID
+func ValidateLoadBalancerNatRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerNatRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LoadBalancerNatRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
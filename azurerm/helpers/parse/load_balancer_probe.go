@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerProbeID is a parsed Resource ID for a LoadBalancerProbe
+type LoadBalancerProbeID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerProbeID returns a LoadBalancerProbeID comprised of the specified components
+func NewLoadBalancerProbeID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerProbeID {
+	return LoadBalancerProbeID{
+		SubscriptionID:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the Resource ID for this LoadBalancerProbe
+func (id LoadBalancerProbeID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/probes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerProbeID parses the specified Resource ID into a LoadBalancerProbeID
+func ParseLoadBalancerProbeID(input string) (*LoadBalancerProbeID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerProbe ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerProbe ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerProbeID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerProbe ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("probes"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerProbe ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerProbe ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerProbeID validates that the specified value is a LoadBalancerProbe ID
+func ValidateLoadBalancerProbeID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerProbeID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LoadBalancerProbe ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LoadBalancerRuleID is a parsed Resource ID for a LoadBalancerRule
+type LoadBalancerRuleID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerRuleID returns a LoadBalancerRuleID comprised of the specified components
+func NewLoadBalancerRuleID(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerRuleID {
+	return LoadBalancerRuleID{
+		SubscriptionID:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the Resource ID for this LoadBalancerRule
+func (id LoadBalancerRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/loadBalancingRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerRuleID parses the specified Resource ID into a LoadBalancerRuleID
+func ParseLoadBalancerRuleID(input string) (*LoadBalancerRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LoadBalancerRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("loadBalancingRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LoadBalancerRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLoadBalancerRuleID validates that the specified value is a LoadBalancerRule ID
+func ValidateLoadBalancerRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLoadBalancerRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LoadBalancerRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
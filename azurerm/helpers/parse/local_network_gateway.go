@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LocalNetworkGatewayID is a parsed Resource ID for a LocalNetworkGateway
+type LocalNetworkGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLocalNetworkGatewayID returns a LocalNetworkGatewayID comprised of the specified components
+func NewLocalNetworkGatewayID(subscriptionId, resourceGroup, name string) LocalNetworkGatewayID {
+	return LocalNetworkGatewayID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this LocalNetworkGateway
+func (id LocalNetworkGatewayID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/localNetworkGateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLocalNetworkGatewayID parses the specified Resource ID into a LocalNetworkGatewayID
+func ParseLocalNetworkGatewayID(input string) (*LocalNetworkGatewayID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LocalNetworkGateway ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a LocalNetworkGateway ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := LocalNetworkGatewayID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("localNetworkGateways"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LocalNetworkGateway ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LocalNetworkGateway ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLocalNetworkGatewayID validates that the specified value is a LocalNetworkGateway ID
+func ValidateLocalNetworkGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLocalNetworkGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LocalNetworkGateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
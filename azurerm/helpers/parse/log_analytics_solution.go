@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogAnalyticsSolutionID is a parsed Resource ID for a LogAnalyticsSolution
+type LogAnalyticsSolutionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogAnalyticsSolutionID returns a LogAnalyticsSolutionID comprised of the specified components
+func NewLogAnalyticsSolutionID(subscriptionId, resourceGroup, name string) LogAnalyticsSolutionID {
+	return LogAnalyticsSolutionID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this LogAnalyticsSolution
+func (id LogAnalyticsSolutionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationsManagement/solutions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLogAnalyticsSolutionID parses the specified Resource ID into a LogAnalyticsSolutionID
+func ParseLogAnalyticsSolutionID(input string) (*LogAnalyticsSolutionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsSolution ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.OperationsManagement") {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsSolution ID: expected the Resource Provider %q but got %q", input, "Microsoft.OperationsManagement", id.Provider)
+	}
+
+	resourceId := LogAnalyticsSolutionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("solutions"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsSolution ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsSolution ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogAnalyticsSolutionID validates that the specified value is a LogAnalyticsSolution ID
+func ValidateLogAnalyticsSolutionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLogAnalyticsSolutionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LogAnalyticsSolution ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogAnalyticsWorkspaceID is a parsed Resource ID for a LogAnalyticsWorkspace
+type LogAnalyticsWorkspaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogAnalyticsWorkspaceID returns a LogAnalyticsWorkspaceID comprised of the specified components
+func NewLogAnalyticsWorkspaceID(subscriptionId, resourceGroup, name string) LogAnalyticsWorkspaceID {
+	return LogAnalyticsWorkspaceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this LogAnalyticsWorkspace
+func (id LogAnalyticsWorkspaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLogAnalyticsWorkspaceID parses the specified Resource ID into a LogAnalyticsWorkspaceID
+func ParseLogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspace ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.OperationalInsights") {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspace ID: expected the Resource Provider %q but got %q", input, "Microsoft.OperationalInsights", id.Provider)
+	}
+
+	resourceId := LogAnalyticsWorkspaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("workspaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspace ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspace ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogAnalyticsWorkspaceID validates that the specified value is a LogAnalyticsWorkspace ID
+func ValidateLogAnalyticsWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLogAnalyticsWorkspaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LogAnalyticsWorkspace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogAnalyticsWorkspaceLinkedServiceID is a parsed Resource ID for a LogAnalyticsWorkspaceLinkedService
+type LogAnalyticsWorkspaceLinkedServiceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	WorkspaceName  string
+	Name           string
+}
+
+// NewLogAnalyticsWorkspaceLinkedServiceID returns a LogAnalyticsWorkspaceLinkedServiceID comprised of the specified components
+func NewLogAnalyticsWorkspaceLinkedServiceID(subscriptionId, resourceGroup, workspaceName, name string) LogAnalyticsWorkspaceLinkedServiceID {
+	return LogAnalyticsWorkspaceLinkedServiceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this LogAnalyticsWorkspaceLinkedService
+func (id LogAnalyticsWorkspaceLinkedServiceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/linkedservices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.WorkspaceName, id.Name)
+}
+
+// ParseLogAnalyticsWorkspaceLinkedServiceID parses the specified Resource ID into a LogAnalyticsWorkspaceLinkedServiceID
+func ParseLogAnalyticsWorkspaceLinkedServiceID(input string) (*LogAnalyticsWorkspaceLinkedServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspaceLinkedService ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.OperationalInsights") {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspaceLinkedService ID: expected the Resource Provider %q but got %q", input, "Microsoft.OperationalInsights", id.Provider)
+	}
+
+	resourceId := LogAnalyticsWorkspaceLinkedServiceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspaceLinkedService ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("linkedservices"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspaceLinkedService ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogAnalyticsWorkspaceLinkedService ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogAnalyticsWorkspaceLinkedServiceID validates that the specified value is a LogAnalyticsWorkspaceLinkedService ID
+func ValidateLogAnalyticsWorkspaceLinkedServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLogAnalyticsWorkspaceLinkedServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LogAnalyticsWorkspaceLinkedService ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogicAppActionID is a parsed Resource ID for a LogicAppAction
+type LogicAppActionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	WorkflowName   string
+	Name           string
+}
+
+// NewLogicAppActionID returns a LogicAppActionID comprised of the specified components
+func NewLogicAppActionID(subscriptionId, resourceGroup, workflowName, name string) LogicAppActionID {
+	return LogicAppActionID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkflowName:   workflowName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this LogicAppAction
+func (id LogicAppActionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s/actions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.WorkflowName, id.Name)
+}
+
+// ParseLogicAppActionID parses the specified Resource ID into a LogicAppActionID
+func ParseLogicAppActionID(input string) (*LogicAppActionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppAction ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Logic") {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppAction ID: expected the Resource Provider %q but got %q", input, "Microsoft.Logic", id.Provider)
+	}
+
+	resourceId := LogicAppActionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.WorkflowName, err = id.PopSegment("workflows"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppAction ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("actions"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppAction ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppAction ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogicAppActionID validates that the specified value is a LogicAppAction ID
+func ValidateLogicAppActionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLogicAppActionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LogicAppAction ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogicAppTriggerID is a parsed Resource ID for a LogicAppTrigger
+type LogicAppTriggerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	WorkflowName   string
+	Name           string
+}
+
+// NewLogicAppTriggerID returns a LogicAppTriggerID comprised of the specified components
+func NewLogicAppTriggerID(subscriptionId, resourceGroup, workflowName, name string) LogicAppTriggerID {
+	return LogicAppTriggerID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkflowName:   workflowName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this LogicAppTrigger
+func (id LogicAppTriggerID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s/triggers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.WorkflowName, id.Name)
+}
+
+// ParseLogicAppTriggerID parses the specified Resource ID into a LogicAppTriggerID
+func ParseLogicAppTriggerID(input string) (*LogicAppTriggerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppTrigger ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Logic") {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppTrigger ID: expected the Resource Provider %q but got %q", input, "Microsoft.Logic", id.Provider)
+	}
+
+	resourceId := LogicAppTriggerID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.WorkflowName, err = id.PopSegment("workflows"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppTrigger ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("triggers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppTrigger ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppTrigger ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogicAppTriggerID validates that the specified value is a LogicAppTrigger ID
+func ValidateLogicAppTriggerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLogicAppTriggerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LogicAppTrigger ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// LogicAppWorkflowID is a parsed Resource ID for a LogicAppWorkflow
+type LogicAppWorkflowID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogicAppWorkflowID returns a LogicAppWorkflowID comprised of the specified components
+func NewLogicAppWorkflowID(subscriptionId, resourceGroup, name string) LogicAppWorkflowID {
+	return LogicAppWorkflowID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this LogicAppWorkflow
+func (id LogicAppWorkflowID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/workflows/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLogicAppWorkflowID parses the specified Resource ID into a LogicAppWorkflowID
+func ParseLogicAppWorkflowID(input string) (*LogicAppWorkflowID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppWorkflow ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Logic") {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppWorkflow ID: expected the Resource Provider %q but got %q", input, "Microsoft.Logic", id.Provider)
+	}
+
+	resourceId := LogicAppWorkflowID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("workflows"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppWorkflow ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a LogicAppWorkflow ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogicAppWorkflowID validates that the specified value is a LogicAppWorkflow ID
+func ValidateLogicAppWorkflowID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseLogicAppWorkflowID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a LogicAppWorkflow ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ManagedDiskID is a parsed Resource ID for a ManagedDisk
+type ManagedDiskID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewManagedDiskID returns a ManagedDiskID comprised of the specified components
+func NewManagedDiskID(subscriptionId, resourceGroup, name string) ManagedDiskID {
+	return ManagedDiskID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ManagedDisk
+func (id ManagedDiskID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/disks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseManagedDiskID parses the specified Resource ID into a ManagedDiskID
+func ParseManagedDiskID(input string) (*ManagedDiskID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ManagedDisk ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a ManagedDisk ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := ManagedDiskID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("disks"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ManagedDisk ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ManagedDisk ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateManagedDiskID validates that the specified value is a ManagedDisk ID
+func ValidateManagedDiskID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseManagedDiskID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ManagedDisk ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MetricAlertRuleID is a parsed Resource ID for a MetricAlertRule
+type MetricAlertRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMetricAlertRuleID returns a MetricAlertRuleID comprised of the specified components
+func NewMetricAlertRuleID(subscriptionId, resourceGroup, name string) MetricAlertRuleID {
+	return MetricAlertRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MetricAlertRule
+func (id MetricAlertRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/alertrules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseMetricAlertRuleID parses the specified Resource ID into a MetricAlertRuleID
+func ParseMetricAlertRuleID(input string) (*MetricAlertRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MetricAlertRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing %q as a MetricAlertRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MetricAlertRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("alertrules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MetricAlertRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MetricAlertRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMetricAlertRuleID validates that the specified value is a MetricAlertRule ID
+func ValidateMetricAlertRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMetricAlertRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MetricAlertRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
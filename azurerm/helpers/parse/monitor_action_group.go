@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorActionGroupID is a parsed Resource ID for a MonitorActionGroup
+type MonitorActionGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorActionGroupID returns a MonitorActionGroupID comprised of the specified components
+func NewMonitorActionGroupID(subscriptionId, resourceGroup, name string) MonitorActionGroupID {
+	return MonitorActionGroupID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MonitorActionGroup
+func (id MonitorActionGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/actionGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorActionGroupID parses the specified Resource ID into a MonitorActionGroupID
+func ParseMonitorActionGroupID(input string) (*MonitorActionGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActionGroup ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActionGroup ID: expected the Resource Provider %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorActionGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("actionGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActionGroup ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActionGroup ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorActionGroupID validates that the specified value is a MonitorActionGroup ID
+func ValidateMonitorActionGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMonitorActionGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MonitorActionGroup ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
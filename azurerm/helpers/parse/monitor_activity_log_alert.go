@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorActivityLogAlertID is a parsed Resource ID for a MonitorActivityLogAlert
+type MonitorActivityLogAlertID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorActivityLogAlertID returns a MonitorActivityLogAlertID comprised of the specified components
+func NewMonitorActivityLogAlertID(subscriptionId, resourceGroup, name string) MonitorActivityLogAlertID {
+	return MonitorActivityLogAlertID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MonitorActivityLogAlert
+func (id MonitorActivityLogAlertID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/activityLogAlerts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorActivityLogAlertID parses the specified Resource ID into a MonitorActivityLogAlertID
+func ParseMonitorActivityLogAlertID(input string) (*MonitorActivityLogAlertID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActivityLogAlert ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActivityLogAlert ID: expected the Resource Provider %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorActivityLogAlertID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("activityLogAlerts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActivityLogAlert ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorActivityLogAlert ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorActivityLogAlertID validates that the specified value is a MonitorActivityLogAlert ID
+func ValidateMonitorActivityLogAlertID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMonitorActivityLogAlertID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MonitorActivityLogAlert ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MonitorMetricAlertID is a parsed Resource ID for a MonitorMetricAlert
+type MonitorMetricAlertID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorMetricAlertID returns a MonitorMetricAlertID comprised of the specified components
+func NewMonitorMetricAlertID(subscriptionId, resourceGroup, name string) MonitorMetricAlertID {
+	return MonitorMetricAlertID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MonitorMetricAlert
+func (id MonitorMetricAlertID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/metricAlerts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorMetricAlertID parses the specified Resource ID into a MonitorMetricAlertID
+func ParseMonitorMetricAlertID(input string) (*MonitorMetricAlertID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorMetricAlert ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Insights") {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorMetricAlert ID: expected the Resource Provider %q but got %q", input, "Microsoft.Insights", id.Provider)
+	}
+
+	resourceId := MonitorMetricAlertID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("metricAlerts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorMetricAlert ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MonitorMetricAlert ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMonitorMetricAlertID validates that the specified value is a MonitorMetricAlert ID
+func ValidateMonitorMetricAlertID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMonitorMetricAlertID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MonitorMetricAlert ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
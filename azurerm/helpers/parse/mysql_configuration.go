@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySqlConfigurationID is a parsed Resource ID for a MySqlConfiguration
+type MySqlConfigurationID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySqlConfigurationID returns a MySqlConfigurationID comprised of the specified components
+func NewMySqlConfigurationID(subscriptionId, resourceGroup, serverName, name string) MySqlConfigurationID {
+	return MySqlConfigurationID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MySqlConfiguration
+func (id MySqlConfigurationID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySqlConfigurationID parses the specified Resource ID into a MySqlConfigurationID
+func ParseMySqlConfigurationID(input string) (*MySqlConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlConfiguration ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlConfiguration ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySqlConfigurationID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlConfiguration ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("configurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlConfiguration ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlConfiguration ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySqlConfigurationID validates that the specified value is a MySqlConfiguration ID
+func ValidateMySqlConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMySqlConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MySqlConfiguration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySqlDatabaseID is a parsed Resource ID for a MySqlDatabase
+type MySqlDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySqlDatabaseID returns a MySqlDatabaseID comprised of the specified components
+func NewMySqlDatabaseID(subscriptionId, resourceGroup, serverName, name string) MySqlDatabaseID {
+	return MySqlDatabaseID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MySqlDatabase
+func (id MySqlDatabaseID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySqlDatabaseID parses the specified Resource ID into a MySqlDatabaseID
+func ParseMySqlDatabaseID(input string) (*MySqlDatabaseID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlDatabase ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlDatabase ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySqlDatabaseID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlDatabase ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlDatabase ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlDatabase ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySqlDatabaseID validates that the specified value is a MySqlDatabase ID
+func ValidateMySqlDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMySqlDatabaseID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MySqlDatabase ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
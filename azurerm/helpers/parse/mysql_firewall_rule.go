@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySqlFirewallRuleID is a parsed Resource ID for a MySqlFirewallRule
+type MySqlFirewallRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySqlFirewallRuleID returns a MySqlFirewallRuleID comprised of the specified components
+func NewMySqlFirewallRuleID(subscriptionId, resourceGroup, serverName, name string) MySqlFirewallRuleID {
+	return MySqlFirewallRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MySqlFirewallRule
+func (id MySqlFirewallRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySqlFirewallRuleID parses the specified Resource ID into a MySqlFirewallRuleID
+func ParseMySqlFirewallRuleID(input string) (*MySqlFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlFirewallRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlFirewallRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySqlFirewallRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlFirewallRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlFirewallRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlFirewallRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySqlFirewallRuleID validates that the specified value is a MySqlFirewallRule ID
+func ValidateMySqlFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMySqlFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MySqlFirewallRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySqlServerID is a parsed Resource ID for a MySqlServer
+type MySqlServerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMySqlServerID returns a MySqlServerID comprised of the specified components
+func NewMySqlServerID(subscriptionId, resourceGroup, name string) MySqlServerID {
+	return MySqlServerID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MySqlServer
+func (id MySqlServerID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseMySqlServerID parses the specified Resource ID into a MySqlServerID
+func ParseMySqlServerID(input string) (*MySqlServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlServer ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlServer ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySqlServerID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlServer ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlServer ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySqlServerID validates that the specified value is a MySqlServer ID
+func ValidateMySqlServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMySqlServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MySqlServer ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
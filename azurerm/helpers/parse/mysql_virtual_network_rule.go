@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// MySqlVirtualNetworkRuleID is a parsed Resource ID for a MySqlVirtualNetworkRule
+type MySqlVirtualNetworkRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewMySqlVirtualNetworkRuleID returns a MySqlVirtualNetworkRuleID comprised of the specified components
+func NewMySqlVirtualNetworkRuleID(subscriptionId, resourceGroup, serverName, name string) MySqlVirtualNetworkRuleID {
+	return MySqlVirtualNetworkRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this MySqlVirtualNetworkRule
+func (id MySqlVirtualNetworkRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/virtualNetworkRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseMySqlVirtualNetworkRuleID parses the specified Resource ID into a MySqlVirtualNetworkRuleID
+func ParseMySqlVirtualNetworkRuleID(input string) (*MySqlVirtualNetworkRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforMySQL") {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlVirtualNetworkRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforMySQL", id.Provider)
+	}
+
+	resourceId := MySqlVirtualNetworkRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateMySqlVirtualNetworkRuleID validates that the specified value is a MySqlVirtualNetworkRule ID
+func ValidateMySqlVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseMySqlVirtualNetworkRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a MySqlVirtualNetworkRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkInterfaceID is a parsed Resource ID for a NetworkInterface
+type NetworkInterfaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkInterfaceID returns a NetworkInterfaceID comprised of the specified components
+func NewNetworkInterfaceID(subscriptionId, resourceGroup, name string) NetworkInterfaceID {
+	return NetworkInterfaceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this NetworkInterface
+func (id NetworkInterfaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkInterfaceID parses the specified Resource ID into a NetworkInterfaceID
+func ParseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkInterface ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkInterface ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkInterfaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkInterfaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkInterface ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkInterface ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkInterfaceID validates that the specified value is a NetworkInterface ID
+func ValidateNetworkInterfaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkInterfaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a NetworkInterface ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkSecurityGroupID is a parsed Resource ID for a NetworkSecurityGroup
+type NetworkSecurityGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkSecurityGroupID returns a NetworkSecurityGroupID comprised of the specified components
+func NewNetworkSecurityGroupID(subscriptionId, resourceGroup, name string) NetworkSecurityGroupID {
+	return NetworkSecurityGroupID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this NetworkSecurityGroup
+func (id NetworkSecurityGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkSecurityGroupID parses the specified Resource ID into a NetworkSecurityGroupID
+func ParseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityGroup ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityGroup ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkSecurityGroupID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkSecurityGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityGroup ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityGroup ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkSecurityGroupID validates that the specified value is a NetworkSecurityGroup ID
+func ValidateNetworkSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkSecurityGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a NetworkSecurityGroup ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
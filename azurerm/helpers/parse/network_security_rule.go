@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkSecurityRuleID is a parsed Resource ID for a NetworkSecurityRule
+type NetworkSecurityRuleID struct {
+	SubscriptionID           string
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	Name                     string
+}
+
+// NewNetworkSecurityRuleID returns a NetworkSecurityRuleID comprised of the specified components
+func NewNetworkSecurityRuleID(subscriptionId, resourceGroup, networkSecurityGroupName, name string) NetworkSecurityRuleID {
+	return NetworkSecurityRuleID{
+		SubscriptionID:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		NetworkSecurityGroupName: networkSecurityGroupName,
+		Name:                     name,
+	}
+}
+
+// ID returns the Resource ID for this NetworkSecurityRule
+func (id NetworkSecurityRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s/securityRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
+}
+
+// ParseNetworkSecurityRuleID parses the specified Resource ID into a NetworkSecurityRuleID
+func ParseNetworkSecurityRuleID(input string) (*NetworkSecurityRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkSecurityRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NetworkSecurityGroupName, err = id.PopSegment("networkSecurityGroups"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("securityRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkSecurityRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkSecurityRuleID validates that the specified value is a NetworkSecurityRule ID
+func ValidateNetworkSecurityRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkSecurityRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a NetworkSecurityRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NetworkWatcherID is a parsed Resource ID for a NetworkWatcher
+type NetworkWatcherID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkWatcherID returns a NetworkWatcherID comprised of the specified components
+func NewNetworkWatcherID(subscriptionId, resourceGroup, name string) NetworkWatcherID {
+	return NetworkWatcherID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this NetworkWatcher
+func (id NetworkWatcherID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkWatcherID parses the specified Resource ID into a NetworkWatcherID
+func ParseNetworkWatcherID(input string) (*NetworkWatcherID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkWatcher ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkWatcher ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkWatcherID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkWatchers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkWatcher ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NetworkWatcher ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkWatcherID validates that the specified value is a NetworkWatcher ID
+func ValidateNetworkWatcherID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNetworkWatcherID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a NetworkWatcher ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NotificationHubID is a parsed Resource ID for a NotificationHub
+type NotificationHubID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewNotificationHubID returns a NotificationHubID comprised of the specified components
+func NewNotificationHubID(subscriptionId, resourceGroup, namespaceName, name string) NotificationHubID {
+	return NotificationHubID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this NotificationHub
+func (id NotificationHubID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseNotificationHubID parses the specified Resource ID into a NotificationHubID
+func ParseNotificationHubID(input string) (*NotificationHubID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHub ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.NotificationHubs") {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHub ID: expected the Resource Provider %q but got %q", input, "Microsoft.NotificationHubs", id.Provider)
+	}
+
+	resourceId := NotificationHubID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHub ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("notificationHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHub ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHub ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNotificationHubID validates that the specified value is a NotificationHub ID
+func ValidateNotificationHubID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNotificationHubID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a NotificationHub ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NotificationHubAuthorizationRuleID is a parsed Resource ID for a NotificationHubAuthorizationRule
+type NotificationHubAuthorizationRuleID struct {
+	SubscriptionID      string
+	ResourceGroup       string
+	NamespaceName       string
+	NotificationHubName string
+	Name                string
+}
+
+// NewNotificationHubAuthorizationRuleID returns a NotificationHubAuthorizationRuleID comprised of the specified components
+func NewNotificationHubAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, notificationHubName, name string) NotificationHubAuthorizationRuleID {
+	return NotificationHubAuthorizationRuleID{
+		SubscriptionID:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		NamespaceName:       namespaceName,
+		NotificationHubName: notificationHubName,
+		Name:                name,
+	}
+}
+
+// ID returns the Resource ID for this NotificationHubAuthorizationRule
+func (id NotificationHubAuthorizationRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s/AuthorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.Name)
+}
+
+// ParseNotificationHubAuthorizationRuleID parses the specified Resource ID into a NotificationHubAuthorizationRuleID
+func ParseNotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.NotificationHubs") {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubAuthorizationRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.NotificationHubs", id.Provider)
+	}
+
+	resourceId := NotificationHubAuthorizationRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.NotificationHubName, err = id.PopSegment("notificationHubs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("AuthorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubAuthorizationRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNotificationHubAuthorizationRuleID validates that the specified value is a NotificationHubAuthorizationRule ID
+func ValidateNotificationHubAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNotificationHubAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a NotificationHubAuthorizationRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
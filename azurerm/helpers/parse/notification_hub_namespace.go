@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// NotificationHubNamespaceID is a parsed Resource ID for a NotificationHubNamespace
+type NotificationHubNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNotificationHubNamespaceID returns a NotificationHubNamespaceID comprised of the specified components
+func NewNotificationHubNamespaceID(subscriptionId, resourceGroup, name string) NotificationHubNamespaceID {
+	return NotificationHubNamespaceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this NotificationHubNamespace
+func (id NotificationHubNamespaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseNotificationHubNamespaceID parses the specified Resource ID into a NotificationHubNamespaceID
+func ParseNotificationHubNamespaceID(input string) (*NotificationHubNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubNamespace ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.NotificationHubs") {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubNamespace ID: expected the Resource Provider %q but got %q", input, "Microsoft.NotificationHubs", id.Provider)
+	}
+
+	resourceId := NotificationHubNamespaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubNamespace ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a NotificationHubNamespace ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNotificationHubNamespaceID validates that the specified value is a NotificationHubNamespace ID
+func ValidateNotificationHubNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseNotificationHubNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a NotificationHubNamespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
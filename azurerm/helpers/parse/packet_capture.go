@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PacketCaptureID is a parsed Resource ID for a PacketCapture
+type PacketCaptureID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	NetworkWatcherName string
+	Name               string
+}
+
+// NewPacketCaptureID returns a PacketCaptureID comprised of the specified components
+func NewPacketCaptureID(subscriptionId, resourceGroup, networkWatcherName, name string) PacketCaptureID {
+	return PacketCaptureID{
+		SubscriptionID:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkWatcherName: networkWatcherName,
+		Name:               name,
+	}
+}
+
+// ID returns the Resource ID for this PacketCapture
+func (id PacketCaptureID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s/packetCaptures/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+}
+
+// ParsePacketCaptureID parses the specified Resource ID into a PacketCaptureID
+func ParsePacketCaptureID(input string) (*PacketCaptureID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PacketCapture ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a PacketCapture ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PacketCaptureID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PacketCapture ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("packetCaptures"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PacketCapture ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PacketCapture ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePacketCaptureID validates that the specified value is a PacketCapture ID
+func ValidatePacketCaptureID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePacketCaptureID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a PacketCapture ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLConfigurationID is a parsed Resource ID for a PostgreSQLConfiguration
+type PostgreSQLConfigurationID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLConfigurationID returns a PostgreSQLConfigurationID comprised of the specified components
+func NewPostgreSQLConfigurationID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLConfigurationID {
+	return PostgreSQLConfigurationID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this PostgreSQLConfiguration
+func (id PostgreSQLConfigurationID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/configurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLConfigurationID parses the specified Resource ID into a PostgreSQLConfigurationID
+func ParsePostgreSQLConfigurationID(input string) (*PostgreSQLConfigurationID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLConfiguration ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLConfiguration ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLConfigurationID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLConfiguration ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("configurations"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLConfiguration ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLConfiguration ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLConfigurationID validates that the specified value is a PostgreSQLConfiguration ID
+func ValidatePostgreSQLConfigurationID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePostgreSQLConfigurationID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a PostgreSQLConfiguration ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLDatabaseID is a parsed Resource ID for a PostgreSQLDatabase
+type PostgreSQLDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLDatabaseID returns a PostgreSQLDatabaseID comprised of the specified components
+func NewPostgreSQLDatabaseID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLDatabaseID {
+	return PostgreSQLDatabaseID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this PostgreSQLDatabase
+func (id PostgreSQLDatabaseID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLDatabaseID parses the specified Resource ID into a PostgreSQLDatabaseID
+func ParsePostgreSQLDatabaseID(input string) (*PostgreSQLDatabaseID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLDatabase ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLDatabase ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLDatabaseID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLDatabase ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLDatabase ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLDatabase ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLDatabaseID validates that the specified value is a PostgreSQLDatabase ID
+func ValidatePostgreSQLDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePostgreSQLDatabaseID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a PostgreSQLDatabase ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLFirewallRuleID is a parsed Resource ID for a PostgreSQLFirewallRule
+type PostgreSQLFirewallRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLFirewallRuleID returns a PostgreSQLFirewallRuleID comprised of the specified components
+func NewPostgreSQLFirewallRuleID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLFirewallRuleID {
+	return PostgreSQLFirewallRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this PostgreSQLFirewallRule
+func (id PostgreSQLFirewallRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLFirewallRuleID parses the specified Resource ID into a PostgreSQLFirewallRuleID
+func ParsePostgreSQLFirewallRuleID(input string) (*PostgreSQLFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLFirewallRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLFirewallRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLFirewallRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLFirewallRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLFirewallRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLFirewallRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLFirewallRuleID validates that the specified value is a PostgreSQLFirewallRule ID
+func ValidatePostgreSQLFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePostgreSQLFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a PostgreSQLFirewallRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
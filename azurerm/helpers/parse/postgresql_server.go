@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLServerID is a parsed Resource ID for a PostgreSQLServer
+type PostgreSQLServerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPostgreSQLServerID returns a PostgreSQLServerID comprised of the specified components
+func NewPostgreSQLServerID(subscriptionId, resourceGroup, name string) PostgreSQLServerID {
+	return PostgreSQLServerID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this PostgreSQLServer
+func (id PostgreSQLServerID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParsePostgreSQLServerID parses the specified Resource ID into a PostgreSQLServerID
+func ParsePostgreSQLServerID(input string) (*PostgreSQLServerID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLServer ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLServer ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLServerID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLServer ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLServer ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLServerID validates that the specified value is a PostgreSQLServer ID
+func ValidatePostgreSQLServerID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePostgreSQLServerID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a PostgreSQLServer ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
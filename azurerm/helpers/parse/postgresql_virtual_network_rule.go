@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PostgreSQLVirtualNetworkRuleID is a parsed Resource ID for a PostgreSQLVirtualNetworkRule
+type PostgreSQLVirtualNetworkRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewPostgreSQLVirtualNetworkRuleID returns a PostgreSQLVirtualNetworkRuleID comprised of the specified components
+func NewPostgreSQLVirtualNetworkRuleID(subscriptionId, resourceGroup, serverName, name string) PostgreSQLVirtualNetworkRuleID {
+	return PostgreSQLVirtualNetworkRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this PostgreSQLVirtualNetworkRule
+func (id PostgreSQLVirtualNetworkRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/virtualNetworkRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParsePostgreSQLVirtualNetworkRuleID parses the specified Resource ID into a PostgreSQLVirtualNetworkRuleID
+func ParsePostgreSQLVirtualNetworkRuleID(input string) (*PostgreSQLVirtualNetworkRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DBforPostgreSQL") {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLVirtualNetworkRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.DBforPostgreSQL", id.Provider)
+	}
+
+	resourceId := PostgreSQLVirtualNetworkRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQLVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePostgreSQLVirtualNetworkRuleID validates that the specified value is a PostgreSQLVirtualNetworkRule ID
+func ValidatePostgreSQLVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePostgreSQLVirtualNetworkRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a PostgreSQLVirtualNetworkRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// PublicIPID is a parsed Resource ID for a PublicIP
+type PublicIPID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPublicIPID returns a PublicIPID comprised of the specified components
+func NewPublicIPID(subscriptionId, resourceGroup, name string) PublicIPID {
+	return PublicIPID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this PublicIP
+func (id PublicIPID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParsePublicIPID parses the specified Resource ID into a PublicIPID
+func ParsePublicIPID(input string) (*PublicIPID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PublicIP ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a PublicIP ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PublicIPID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("publicIPAddresses"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PublicIP ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PublicIP ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePublicIPID validates that the specified value is a PublicIP ID
+func ValidatePublicIPID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePublicIPID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a PublicIP ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
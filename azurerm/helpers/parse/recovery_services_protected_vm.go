@@ -0,0 +1,92 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RecoveryServicesProtectedVmID is a parsed Resource ID for a RecoveryServicesProtectedVm
+type RecoveryServicesProtectedVmID struct {
+	SubscriptionID          string
+	ResourceGroup           string
+	VaultName               string
+	ProtectionContainerName string
+	Name                    string
+}
+
+// NewRecoveryServicesProtectedVmID returns a RecoveryServicesProtectedVmID comprised of the specified components
+func NewRecoveryServicesProtectedVmID(subscriptionId, resourceGroup, vaultName, protectionContainerName, name string) RecoveryServicesProtectedVmID {
+	return RecoveryServicesProtectedVmID{
+		SubscriptionID:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		VaultName:               vaultName,
+		ProtectionContainerName: protectionContainerName,
+		Name:                    name,
+	}
+}
+
+// ID returns the Resource ID for this RecoveryServicesProtectedVm
+func (id RecoveryServicesProtectedVmID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupFabrics/Azure/protectionContainers/%s/protectedItems/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.VaultName, id.ProtectionContainerName, id.Name)
+}
+
+// ParseRecoveryServicesProtectedVmID parses the specified Resource ID into a RecoveryServicesProtectedVmID
+func ParseRecoveryServicesProtectedVmID(input string) (*RecoveryServicesProtectedVmID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.RecoveryServices") {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: expected the Resource Provider %q but got %q", input, "Microsoft.RecoveryServices", id.Provider)
+	}
+
+	resourceId := RecoveryServicesProtectedVmID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: %+v", input, err)
+	}
+
+	if value, err := id.PopSegment("backupFabrics"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: %+v", input, err)
+	} else if !strings.EqualFold(value, "Azure") {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: expected the `backupFabrics` segment to be %q but got %q", input, "Azure", value)
+	}
+
+	if resourceId.ProtectionContainerName, err = id.PopSegment("protectionContainers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("protectedItems"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectedVm ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRecoveryServicesProtectedVmID validates that the specified value is a RecoveryServicesProtectedVm ID
+func ValidateRecoveryServicesProtectedVmID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRecoveryServicesProtectedVmID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a RecoveryServicesProtectedVm ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,147 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func TestRecoveryServicesProtectedVmIDFormatter(t *testing.T) {
+	actual := NewRecoveryServicesProtectedVmID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1", "protectionContainer1", "recoveryservicesprotectedvm1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/protectionContainer1/protectedItems/recoveryservicesprotectedvm1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseRecoveryServicesProtectedVmID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *RecoveryServicesProtectedVmID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing SubscriptionID Value",
+			Input:    "/subscriptions/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ResourceGroup",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ResourceGroup Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Provider Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing VaultName",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing VaultName Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing backupFabrics",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing backupFabrics Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ProtectionContainerName",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing ProtectionContainerName Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/protectionContainer1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/protectionContainer1/protectedItems/",
+			Expected: nil,
+		},
+		{
+			Name:  "RecoveryServicesProtectedVm ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/protectionContainer1/protectedItems/recoveryservicesprotectedvm1",
+			Expected: &RecoveryServicesProtectedVmID{
+				SubscriptionID:          "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:           "resGroup1",
+				VaultName:               "vault1",
+				ProtectionContainerName: "protectionContainer1",
+				Name:                    "recoveryservicesprotectedvm1",
+			},
+		},
+		{
+			Name:  "Upper-cased Segment Names",
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.RecoveryServices/VAULTS/vault1/BACKUPFABRICS/Azure/PROTECTIONCONTAINERS/protectionContainer1/PROTECTEDITEMS/recoveryservicesprotectedvm1",
+			Expected: &RecoveryServicesProtectedVmID{
+				SubscriptionID:          "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:           "resGroup1",
+				VaultName:               "vault1",
+				ProtectionContainerName: "protectionContainer1",
+				Name:                    "recoveryservicesprotectedvm1",
+			},
+		},
+		{
+			Name:     "Additional Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/protectionContainer1/protectedItems/recoveryservicesprotectedvm1/additional/segment1",
+			Expected: nil,
+		},
+		{
+			Name:     "Different Resource Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Different/vaults/vault1/backupFabrics/Azure/protectionContainers/protectionContainer1/protectedItems/recoveryservicesprotectedvm1",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseRecoveryServicesProtectedVmID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value: %+v", *actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RecoveryServicesProtectionPolicyVmID is a parsed Resource ID for a RecoveryServicesProtectionPolicyVm
+type RecoveryServicesProtectionPolicyVmID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	VaultName      string
+	Name           string
+}
+
+// NewRecoveryServicesProtectionPolicyVmID returns a RecoveryServicesProtectionPolicyVmID comprised of the specified components
+func NewRecoveryServicesProtectionPolicyVmID(subscriptionId, resourceGroup, vaultName, name string) RecoveryServicesProtectionPolicyVmID {
+	return RecoveryServicesProtectionPolicyVmID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VaultName:      vaultName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this RecoveryServicesProtectionPolicyVm
+func (id RecoveryServicesProtectionPolicyVmID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.VaultName, id.Name)
+}
+
+// ParseRecoveryServicesProtectionPolicyVmID parses the specified Resource ID into a RecoveryServicesProtectionPolicyVmID
+func ParseRecoveryServicesProtectionPolicyVmID(input string) (*RecoveryServicesProtectionPolicyVmID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectionPolicyVm ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.RecoveryServices") {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectionPolicyVm ID: expected the Resource Provider %q but got %q", input, "Microsoft.RecoveryServices", id.Provider)
+	}
+
+	resourceId := RecoveryServicesProtectionPolicyVmID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectionPolicyVm ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("backupPolicies"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectionPolicyVm ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesProtectionPolicyVm ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRecoveryServicesProtectionPolicyVmID validates that the specified value is a RecoveryServicesProtectionPolicyVm ID
+func ValidateRecoveryServicesProtectionPolicyVmID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRecoveryServicesProtectionPolicyVmID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a RecoveryServicesProtectionPolicyVm ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
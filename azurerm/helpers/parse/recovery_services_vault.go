@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RecoveryServicesVaultID is a parsed Resource ID for a RecoveryServicesVault
+type RecoveryServicesVaultID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRecoveryServicesVaultID returns a RecoveryServicesVaultID comprised of the specified components
+func NewRecoveryServicesVaultID(subscriptionId, resourceGroup, name string) RecoveryServicesVaultID {
+	return RecoveryServicesVaultID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this RecoveryServicesVault
+func (id RecoveryServicesVaultID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseRecoveryServicesVaultID parses the specified Resource ID into a RecoveryServicesVaultID
+func ParseRecoveryServicesVaultID(input string) (*RecoveryServicesVaultID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesVault ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.RecoveryServices") {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesVault ID: expected the Resource Provider %q but got %q", input, "Microsoft.RecoveryServices", id.Provider)
+	}
+
+	resourceId := RecoveryServicesVaultID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("vaults"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesVault ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RecoveryServicesVault ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRecoveryServicesVaultID validates that the specified value is a RecoveryServicesVault ID
+func ValidateRecoveryServicesVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRecoveryServicesVaultID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a RecoveryServicesVault ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RedisCacheID is a parsed Resource ID for a RedisCache
+type RedisCacheID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRedisCacheID returns a RedisCacheID comprised of the specified components
+func NewRedisCacheID(subscriptionId, resourceGroup, name string) RedisCacheID {
+	return RedisCacheID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this RedisCache
+func (id RedisCacheID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseRedisCacheID parses the specified Resource ID into a RedisCacheID
+func ParseRedisCacheID(input string) (*RedisCacheID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RedisCache ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cache") {
+		return nil, fmt.Errorf("Error parsing %q as a RedisCache ID: expected the Resource Provider %q but got %q", input, "Microsoft.Cache", id.Provider)
+	}
+
+	resourceId := RedisCacheID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("Redis"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RedisCache ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RedisCache ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRedisCacheID validates that the specified value is a RedisCache ID
+func ValidateRedisCacheID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRedisCacheID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a RedisCache ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
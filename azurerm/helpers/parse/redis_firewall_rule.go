@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RedisFirewallRuleID is a parsed Resource ID for a RedisFirewallRule
+type RedisFirewallRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	CacheName      string
+	Name           string
+}
+
+// NewRedisFirewallRuleID returns a RedisFirewallRuleID comprised of the specified components
+func NewRedisFirewallRuleID(subscriptionId, resourceGroup, cacheName, name string) RedisFirewallRuleID {
+	return RedisFirewallRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		CacheName:      cacheName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this RedisFirewallRule
+func (id RedisFirewallRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.CacheName, id.Name)
+}
+
+// ParseRedisFirewallRuleID parses the specified Resource ID into a RedisFirewallRuleID
+func ParseRedisFirewallRuleID(input string) (*RedisFirewallRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RedisFirewallRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Cache") {
+		return nil, fmt.Errorf("Error parsing %q as a RedisFirewallRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.Cache", id.Provider)
+	}
+
+	resourceId := RedisFirewallRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.CacheName, err = id.PopSegment("Redis"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RedisFirewallRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RedisFirewallRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RedisFirewallRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRedisFirewallRuleID validates that the specified value is a RedisFirewallRule ID
+func ValidateRedisFirewallRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRedisFirewallRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a RedisFirewallRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
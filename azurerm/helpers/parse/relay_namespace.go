@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RelayNamespaceID is a parsed Resource ID for a RelayNamespace
+type RelayNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRelayNamespaceID returns a RelayNamespaceID comprised of the specified components
+func NewRelayNamespaceID(subscriptionId, resourceGroup, name string) RelayNamespaceID {
+	return RelayNamespaceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this RelayNamespace
+func (id RelayNamespaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Relay/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseRelayNamespaceID parses the specified Resource ID into a RelayNamespaceID
+func ParseRelayNamespaceID(input string) (*RelayNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RelayNamespace ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Relay") {
+		return nil, fmt.Errorf("Error parsing %q as a RelayNamespace ID: expected the Resource Provider %q but got %q", input, "Microsoft.Relay", id.Provider)
+	}
+
+	resourceId := RelayNamespaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RelayNamespace ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RelayNamespace ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRelayNamespaceID validates that the specified value is a RelayNamespace ID
+func ValidateRelayNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRelayNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a RelayNamespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
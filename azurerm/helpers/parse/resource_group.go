@@ -0,0 +1,67 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ResourceGroupID is a parsed Resource ID for a ResourceGroup
+type ResourceGroupID struct {
+	SubscriptionID string
+	Name           string
+}
+
+// NewResourceGroupID returns a ResourceGroupID comprised of the specified components
+func NewResourceGroupID(subscriptionId, name string) ResourceGroupID {
+	return ResourceGroupID{
+		SubscriptionID: subscriptionId,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ResourceGroup
+func (id ResourceGroupID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.Name)
+}
+
+// ParseResourceGroupID parses the specified Resource ID into a ResourceGroupID
+func ParseResourceGroupID(input string) (*ResourceGroupID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ResourceGroup ID: %+v", input, err)
+	}
+
+	if id.Provider != "" {
+		return nil, fmt.Errorf("Error parsing %q as a ResourceGroup ID: expected no Resource Provider but got %q", input, id.Provider)
+	}
+
+	resourceId := ResourceGroupID{
+		SubscriptionID: id.SubscriptionID,
+		Name:           id.ResourceGroup,
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ResourceGroup ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateResourceGroupID validates that the specified value is a ResourceGroup ID
+func ValidateResourceGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseResourceGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ResourceGroup ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,148 @@
+package parse
+
+// This file contains the definitions for the typed Resource IDs within this package - each of which has a Parser,
+// a Formatter and a Validator generated for it. To regenerate these run `make generate` from the root of the repository.
+
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ResourceGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ApiManagement -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ApplicationGateway -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ApplicationInsights -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ApplicationSecurityGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AppService -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AppServiceCustomHostnameBinding -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/hostNameBindings/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AppServicePlan -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AppServiceSlot -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/slots/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutomationAccount -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutomationCredential -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/credentials/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutomationDscConfiguration -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/configurations/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutomationDscNodeConfiguration -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/nodeConfigurations/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutomationModule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/modules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutomationRunbook -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/runbooks/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutomationSchedule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/schedules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AutoscaleSetting -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/autoscalesettings/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=AvailabilitySet -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=CdnEndpoint -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profileName}/endpoints/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=CdnProfile -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=CognitiveAccount -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.CognitiveServices/accounts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ContainerGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ContainerRegistry -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=CosmosDBAccount -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DatabricksWorkspace -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Databricks/workspaces/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DataLakeAnalyticsAccount -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DataLakeAnalyticsFirewallRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{accountName}/firewallRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DataLakeStore -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DataLakeStoreFirewallRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{accountName}/firewallRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DevSpaceController -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevSpaces/controllers/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DevTestLab -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DevTestPolicy -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/policysets/{policySetName}/policies/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DevTestVirtualMachine -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualmachines/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DevTestVirtualNetwork -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualnetworks/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsAAAARecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/AAAA/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsARecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/A/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsCaaRecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/CAA/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsCNameRecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/CNAME/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsMxRecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/MX/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsNsRecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/NS/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsPtrRecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/PTR/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsSrvRecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/SRV/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsTxtRecord -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{zoneName}/TXT/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=DnsZone -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=EventGridTopic -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=EventHub -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=EventHubAuthorizationRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}/authorizationRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=EventHubConsumerGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}/consumergroups/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=EventHubNamespace -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=EventHubNamespaceAuthorizationRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/authorizationRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ExpressRouteCircuit -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ExpressRouteCircuitAuthorization -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{circuitName}/authorizations/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ExpressRouteCircuitPeering -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{circuitName}/peerings/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=Firewall -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=FirewallNetworkRuleCollection -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}/networkRuleCollections/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=FunctionApp -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=Image -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=IotHub -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=IotHubConsumerGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{iotHubName}/eventHubEndpoints/{eventHubEndpointName}/ConsumerGroups/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=KeyVault -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=KubernetesCluster -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LoadBalancer -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LoadBalancerBackendAddressPool -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/backendAddressPools/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LoadBalancerNatPool -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatPools/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LoadBalancerNatRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LoadBalancerProbe -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/probes/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LoadBalancerRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/loadBalancingRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LocalNetworkGateway -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LogAnalyticsSolution -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LogAnalyticsWorkspace -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LogAnalyticsWorkspaceLinkedService -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/linkedservices/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LogicAppAction -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflowName}/actions/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LogicAppTrigger -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflowName}/triggers/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=LogicAppWorkflow -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ManagedDisk -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MetricAlertRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/alertrules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MonitorActionGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/actionGroups/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MonitorActivityLogAlert -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/activityLogAlerts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MonitorMetricAlert -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/metricAlerts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MsSqlElasticPool -filename=mssql_elastic_pool -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MySqlConfiguration -filename=mysql_configuration -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/configurations/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MySqlDatabase -filename=mysql_database -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/databases/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MySqlFirewallRule -filename=mysql_firewall_rule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/firewallRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MySqlServer -filename=mysql_server -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=MySqlVirtualNetworkRule -filename=mysql_virtual_network_rule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/virtualNetworkRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=NetworkInterface -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=NetworkSecurityGroup -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=NetworkSecurityRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroupName}/securityRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=NetworkWatcher -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=NotificationHub -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=NotificationHubAuthorizationRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{notificationHubName}/AuthorizationRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=NotificationHubNamespace -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=PacketCapture -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/packetCaptures/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=PostgreSQLConfiguration -filename=postgresql_configuration -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/configurations/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=PostgreSQLDatabase -filename=postgresql_database -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/databases/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=PostgreSQLFirewallRule -filename=postgresql_firewall_rule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/firewallRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=PostgreSQLServer -filename=postgresql_server -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=PostgreSQLVirtualNetworkRule -filename=postgresql_virtual_network_rule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/virtualNetworkRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=PublicIP -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=RecoveryServicesProtectedVm -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{vaultName}/backupFabrics/Azure/protectionContainers/{protectionContainerName}/protectedItems/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=RecoveryServicesProtectionPolicyVm -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{vaultName}/backupPolicies/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=RecoveryServicesVault -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=RedisCache -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=RedisFirewallRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{cacheName}/firewallRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=RelayNamespace -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=Route -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTableName}/routes/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=RouteTable -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SchedulerJob -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{jobCollectionName}/jobs/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SchedulerJobCollection -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SearchService -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusNamespace -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusNamespaceAuthorizationRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/authorizationRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusQueue -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusQueueAuthorizationRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{queueName}/authorizationRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusSubscription -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusSubscriptionRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{subscriptionName}/rules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusTopic -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceBusTopicAuthorizationRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/authorizationRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=ServiceFabricCluster -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SharedImage -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SharedImageGallery -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SharedImageVersion -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{imageName}/versions/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=Snapshot -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SqlActiveDirectoryAdministrator -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/administrators/activeDirectory
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SqlDatabase -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SqlElasticPool -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SqlFirewallRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/firewallRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SqlServer -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=SqlVirtualNetworkRule -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/virtualNetworkRules/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=StorageAccount -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=StorageManagementPolicy -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/managementPolicies/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=Subnet -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=TemplateDeployment -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=TrafficManagerProfile -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=UserAssignedIdentity -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualMachine -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualMachineDataDiskAttachment -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/dataDisks/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualMachineExtension -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/extensions/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualMachineScaleSet -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualNetwork -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualNetworkGateway -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualNetworkGatewayConnection -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}
+//go:generate go run ../../../scripts/generate-resource-id/main.go -name=VirtualNetworkPeering -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/virtualNetworkPeerings/{name}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RouteID is a parsed Resource ID for a Route
+type RouteID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+// NewRouteID returns a RouteID comprised of the specified components
+func NewRouteID(subscriptionId, resourceGroup, routeTableName, name string) RouteID {
+	return RouteID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RouteTableName: routeTableName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this Route
+func (id RouteID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s/routes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+// ParseRouteID parses the specified Resource ID into a RouteID
+func ParseRouteID(input string) (*RouteID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Route ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a Route ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := RouteID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Route ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("routes"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Route ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Route ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRouteID validates that the specified value is a Route ID
+func ValidateRouteID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRouteID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Route ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// RouteTableID is a parsed Resource ID for a RouteTable
+type RouteTableID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRouteTableID returns a RouteTableID comprised of the specified components
+func NewRouteTableID(subscriptionId, resourceGroup, name string) RouteTableID {
+	return RouteTableID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this RouteTable
+func (id RouteTableID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseRouteTableID parses the specified Resource ID into a RouteTableID
+func ParseRouteTableID(input string) (*RouteTableID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RouteTable ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a RouteTable ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := RouteTableID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("routeTables"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RouteTable ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a RouteTable ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRouteTableID validates that the specified value is a RouteTable ID
+func ValidateRouteTableID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseRouteTableID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a RouteTable ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
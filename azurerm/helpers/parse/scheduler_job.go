@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SchedulerJobID is a parsed Resource ID for a SchedulerJob
+type SchedulerJobID struct {
+	SubscriptionID    string
+	ResourceGroup     string
+	JobCollectionName string
+	Name              string
+}
+
+// NewSchedulerJobID returns a SchedulerJobID comprised of the specified components
+func NewSchedulerJobID(subscriptionId, resourceGroup, jobCollectionName, name string) SchedulerJobID {
+	return SchedulerJobID{
+		SubscriptionID:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		JobCollectionName: jobCollectionName,
+		Name:              name,
+	}
+}
+
+// ID returns the Resource ID for this SchedulerJob
+func (id SchedulerJobID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Scheduler/jobCollections/%s/jobs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.JobCollectionName, id.Name)
+}
+
+// ParseSchedulerJobID parses the specified Resource ID into a SchedulerJobID
+func ParseSchedulerJobID(input string) (*SchedulerJobID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJob ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Scheduler") {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJob ID: expected the Resource Provider %q but got %q", input, "Microsoft.Scheduler", id.Provider)
+	}
+
+	resourceId := SchedulerJobID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.JobCollectionName, err = id.PopSegment("jobCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJob ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("jobs"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJob ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJob ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSchedulerJobID validates that the specified value is a SchedulerJob ID
+func ValidateSchedulerJobID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSchedulerJobID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SchedulerJob ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
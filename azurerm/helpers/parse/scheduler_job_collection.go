@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SchedulerJobCollectionID is a parsed Resource ID for a SchedulerJobCollection
+type SchedulerJobCollectionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSchedulerJobCollectionID returns a SchedulerJobCollectionID comprised of the specified components
+func NewSchedulerJobCollectionID(subscriptionId, resourceGroup, name string) SchedulerJobCollectionID {
+	return SchedulerJobCollectionID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SchedulerJobCollection
+func (id SchedulerJobCollectionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Scheduler/jobCollections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseSchedulerJobCollectionID parses the specified Resource ID into a SchedulerJobCollectionID
+func ParseSchedulerJobCollectionID(input string) (*SchedulerJobCollectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJobCollection ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Scheduler") {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJobCollection ID: expected the Resource Provider %q but got %q", input, "Microsoft.Scheduler", id.Provider)
+	}
+
+	resourceId := SchedulerJobCollectionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("jobCollections"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJobCollection ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SchedulerJobCollection ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSchedulerJobCollectionID validates that the specified value is a SchedulerJobCollection ID
+func ValidateSchedulerJobCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSchedulerJobCollectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SchedulerJobCollection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
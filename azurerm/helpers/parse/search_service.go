@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SearchServiceID is a parsed Resource ID for a SearchService
+type SearchServiceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSearchServiceID returns a SearchServiceID comprised of the specified components
+func NewSearchServiceID(subscriptionId, resourceGroup, name string) SearchServiceID {
+	return SearchServiceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SearchService
+func (id SearchServiceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseSearchServiceID parses the specified Resource ID into a SearchServiceID
+func ParseSearchServiceID(input string) (*SearchServiceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SearchService ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Search") {
+		return nil, fmt.Errorf("Error parsing %q as a SearchService ID: expected the Resource Provider %q but got %q", input, "Microsoft.Search", id.Provider)
+	}
+
+	resourceId := SearchServiceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("searchServices"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SearchService ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SearchService ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSearchServiceID validates that the specified value is a SearchService ID
+func ValidateSearchServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSearchServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SearchService ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusNamespaceID is a parsed Resource ID for a ServiceBusNamespace
+type ServiceBusNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewServiceBusNamespaceID returns a ServiceBusNamespaceID comprised of the specified components
+func NewServiceBusNamespaceID(subscriptionId, resourceGroup, name string) ServiceBusNamespaceID {
+	return ServiceBusNamespaceID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceBusNamespace
+func (id ServiceBusNamespaceID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseServiceBusNamespaceID parses the specified Resource ID into a ServiceBusNamespaceID
+func ParseServiceBusNamespaceID(input string) (*ServiceBusNamespaceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespace ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespace ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusNamespaceID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespace ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespace ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusNamespaceID validates that the specified value is a ServiceBusNamespace ID
+func ValidateServiceBusNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceBusNamespaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceBusNamespace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
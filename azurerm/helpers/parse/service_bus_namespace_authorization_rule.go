@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusNamespaceAuthorizationRuleID is a parsed Resource ID for a ServiceBusNamespaceAuthorizationRule
+type ServiceBusNamespaceAuthorizationRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusNamespaceAuthorizationRuleID returns a ServiceBusNamespaceAuthorizationRuleID comprised of the specified components
+func NewServiceBusNamespaceAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusNamespaceAuthorizationRuleID {
+	return ServiceBusNamespaceAuthorizationRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceBusNamespaceAuthorizationRule
+func (id ServiceBusNamespaceAuthorizationRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusNamespaceAuthorizationRuleID parses the specified Resource ID into a ServiceBusNamespaceAuthorizationRuleID
+func ParseServiceBusNamespaceAuthorizationRuleID(input string) (*ServiceBusNamespaceAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespaceAuthorizationRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusNamespaceAuthorizationRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusNamespaceAuthorizationRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusNamespaceAuthorizationRuleID validates that the specified value is a ServiceBusNamespaceAuthorizationRule ID
+func ValidateServiceBusNamespaceAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceBusNamespaceAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceBusNamespaceAuthorizationRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
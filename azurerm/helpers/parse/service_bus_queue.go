@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusQueueID is a parsed Resource ID for a ServiceBusQueue
+type ServiceBusQueueID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusQueueID returns a ServiceBusQueueID comprised of the specified components
+func NewServiceBusQueueID(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusQueueID {
+	return ServiceBusQueueID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceBusQueue
+func (id ServiceBusQueueID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusQueueID parses the specified Resource ID into a ServiceBusQueueID
+func ParseServiceBusQueueID(input string) (*ServiceBusQueueID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueue ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueue ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusQueueID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueue ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("queues"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueue ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueue ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusQueueID validates that the specified value is a ServiceBusQueue ID
+func ValidateServiceBusQueueID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceBusQueueID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceBusQueue ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusQueueAuthorizationRuleID is a parsed Resource ID for a ServiceBusQueueAuthorizationRule
+type ServiceBusQueueAuthorizationRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	QueueName      string
+	Name           string
+}
+
+// NewServiceBusQueueAuthorizationRuleID returns a ServiceBusQueueAuthorizationRuleID comprised of the specified components
+func NewServiceBusQueueAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, queueName, name string) ServiceBusQueueAuthorizationRuleID {
+	return ServiceBusQueueAuthorizationRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		QueueName:      queueName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceBusQueueAuthorizationRule
+func (id ServiceBusQueueAuthorizationRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.QueueName, id.Name)
+}
+
+// ParseServiceBusQueueAuthorizationRuleID parses the specified Resource ID into a ServiceBusQueueAuthorizationRuleID
+func ParseServiceBusQueueAuthorizationRuleID(input string) (*ServiceBusQueueAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueueAuthorizationRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueueAuthorizationRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusQueueAuthorizationRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueueAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.QueueName, err = id.PopSegment("queues"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueueAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueueAuthorizationRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusQueueAuthorizationRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusQueueAuthorizationRuleID validates that the specified value is a ServiceBusQueueAuthorizationRule ID
+func ValidateServiceBusQueueAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceBusQueueAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceBusQueueAuthorizationRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
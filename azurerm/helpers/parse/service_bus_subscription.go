@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusSubscriptionID is a parsed Resource ID for a ServiceBusSubscription
+type ServiceBusSubscriptionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicName      string
+	Name           string
+}
+
+// NewServiceBusSubscriptionID returns a ServiceBusSubscriptionID comprised of the specified components
+func NewServiceBusSubscriptionID(subscriptionId, resourceGroup, namespaceName, topicName, name string) ServiceBusSubscriptionID {
+	return ServiceBusSubscriptionID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		TopicName:      topicName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceBusSubscription
+func (id ServiceBusSubscriptionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/subscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+// ParseServiceBusSubscriptionID parses the specified Resource ID into a ServiceBusSubscriptionID
+func ParseServiceBusSubscriptionID(input string) (*ServiceBusSubscriptionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusSubscription ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusSubscription ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusSubscriptionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusSubscription ID: %+v", input, err)
+	}
+
+	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusSubscription ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("subscriptions"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusSubscription ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusSubscription ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusSubscriptionID validates that the specified value is a ServiceBusSubscription ID
+func ValidateServiceBusSubscriptionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceBusSubscriptionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceBusSubscription ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusTopicID is a parsed Resource ID for a ServiceBusTopic
+type ServiceBusTopicID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusTopicID returns a ServiceBusTopicID comprised of the specified components
+func NewServiceBusTopicID(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusTopicID {
+	return ServiceBusTopicID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceBusTopic
+func (id ServiceBusTopicID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusTopicID parses the specified Resource ID into a ServiceBusTopicID
+func ParseServiceBusTopicID(input string) (*ServiceBusTopicID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopic ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopic ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusTopicID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopic ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopic ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopic ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusTopicID validates that the specified value is a ServiceBusTopic ID
+func ValidateServiceBusTopicID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceBusTopicID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceBusTopic ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
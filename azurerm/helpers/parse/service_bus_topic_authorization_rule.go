@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceBusTopicAuthorizationRuleID is a parsed Resource ID for a ServiceBusTopicAuthorizationRule
+type ServiceBusTopicAuthorizationRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicName      string
+	Name           string
+}
+
+// NewServiceBusTopicAuthorizationRuleID returns a ServiceBusTopicAuthorizationRuleID comprised of the specified components
+func NewServiceBusTopicAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, topicName, name string) ServiceBusTopicAuthorizationRuleID {
+	return ServiceBusTopicAuthorizationRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		TopicName:      topicName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceBusTopicAuthorizationRule
+func (id ServiceBusTopicAuthorizationRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+// ParseServiceBusTopicAuthorizationRuleID parses the specified Resource ID into a ServiceBusTopicAuthorizationRuleID
+func ParseServiceBusTopicAuthorizationRuleID(input string) (*ServiceBusTopicAuthorizationRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopicAuthorizationRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceBus") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopicAuthorizationRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceBus", id.Provider)
+	}
+
+	resourceId := ServiceBusTopicAuthorizationRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopicAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopicAuthorizationRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopicAuthorizationRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBusTopicAuthorizationRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceBusTopicAuthorizationRuleID validates that the specified value is a ServiceBusTopicAuthorizationRule ID
+func ValidateServiceBusTopicAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceBusTopicAuthorizationRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceBusTopicAuthorizationRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// ServiceFabricClusterID is a parsed Resource ID for a ServiceFabricCluster
+type ServiceFabricClusterID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewServiceFabricClusterID returns a ServiceFabricClusterID comprised of the specified components
+func NewServiceFabricClusterID(subscriptionId, resourceGroup, name string) ServiceFabricClusterID {
+	return ServiceFabricClusterID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this ServiceFabricCluster
+func (id ServiceFabricClusterID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceFabric/clusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseServiceFabricClusterID parses the specified Resource ID into a ServiceFabricClusterID
+func ParseServiceFabricClusterID(input string) (*ServiceFabricClusterID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceFabricCluster ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ServiceFabric") {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceFabricCluster ID: expected the Resource Provider %q but got %q", input, "Microsoft.ServiceFabric", id.Provider)
+	}
+
+	resourceId := ServiceFabricClusterID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("clusters"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceFabricCluster ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceFabricCluster ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateServiceFabricClusterID validates that the specified value is a ServiceFabricCluster ID
+func ValidateServiceFabricClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseServiceFabricClusterID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a ServiceFabricCluster ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
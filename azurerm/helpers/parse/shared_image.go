@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageID is a parsed Resource ID for a SharedImage
+type SharedImageID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	GalleryName    string
+	Name           string
+}
+
+// NewSharedImageID returns a SharedImageID comprised of the specified components
+func NewSharedImageID(subscriptionId, resourceGroup, galleryName, name string) SharedImageID {
+	return SharedImageID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SharedImage
+func (id SharedImageID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.GalleryName, id.Name)
+}
+
+// ParseSharedImageID parses the specified Resource ID into a SharedImageID
+func ParseSharedImageID(input string) (*SharedImageID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImage ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImage ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImage ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("images"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImage ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImage ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageID validates that the specified value is a SharedImage ID
+func ValidateSharedImageID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SharedImage ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
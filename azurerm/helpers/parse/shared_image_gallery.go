@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageGalleryID is a parsed Resource ID for a SharedImageGallery
+type SharedImageGalleryID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSharedImageGalleryID returns a SharedImageGalleryID comprised of the specified components
+func NewSharedImageGalleryID(subscriptionId, resourceGroup, name string) SharedImageGalleryID {
+	return SharedImageGalleryID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SharedImageGallery
+func (id SharedImageGalleryID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseSharedImageGalleryID parses the specified Resource ID into a SharedImageGalleryID
+func ParseSharedImageGalleryID(input string) (*SharedImageGalleryID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageGallery ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageGallery ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageGalleryID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("galleries"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageGallery ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageGallery ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageGalleryID validates that the specified value is a SharedImageGallery ID
+func ValidateSharedImageGalleryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageGalleryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SharedImageGallery ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,86 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SharedImageVersionID is a parsed Resource ID for a SharedImageVersion
+type SharedImageVersionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	GalleryName    string
+	ImageName      string
+	Name           string
+}
+
+// NewSharedImageVersionID returns a SharedImageVersionID comprised of the specified components
+func NewSharedImageVersionID(subscriptionId, resourceGroup, galleryName, imageName, name string) SharedImageVersionID {
+	return SharedImageVersionID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		ImageName:      imageName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SharedImageVersion
+func (id SharedImageVersionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.GalleryName, id.ImageName, id.Name)
+}
+
+// ParseSharedImageVersionID parses the specified Resource ID into a SharedImageVersionID
+func ParseSharedImageVersionID(input string) (*SharedImageVersionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageVersion ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageVersion ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageVersionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageVersion ID: %+v", input, err)
+	}
+
+	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageVersion ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("versions"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageVersion ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SharedImageVersion ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageVersionID validates that the specified value is a SharedImageVersion ID
+func ValidateSharedImageVersionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageVersionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SharedImageVersion ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SnapshotID is a parsed Resource ID for a Snapshot
+type SnapshotID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSnapshotID returns a SnapshotID comprised of the specified components
+func NewSnapshotID(subscriptionId, resourceGroup, name string) SnapshotID {
+	return SnapshotID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this Snapshot
+func (id SnapshotID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/snapshots/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseSnapshotID parses the specified Resource ID into a SnapshotID
+func ParseSnapshotID(input string) (*SnapshotID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Snapshot ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a Snapshot ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SnapshotID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("snapshots"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Snapshot ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Snapshot ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSnapshotID validates that the specified value is a Snapshot ID
+func ValidateSnapshotID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSnapshotID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Snapshot ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlActiveDirectoryAdministratorID is a parsed Resource ID for a SqlActiveDirectoryAdministrator
+type SqlActiveDirectoryAdministratorID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+}
+
+// NewSqlActiveDirectoryAdministratorID returns a SqlActiveDirectoryAdministratorID comprised of the specified components
+func NewSqlActiveDirectoryAdministratorID(subscriptionId, resourceGroup, serverName string) SqlActiveDirectoryAdministratorID {
+	return SqlActiveDirectoryAdministratorID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+	}
+}
+
+// ID returns the Resource ID for this SqlActiveDirectoryAdministrator
+func (id SqlActiveDirectoryAdministratorID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/administrators/activeDirectory"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName)
+}
+
+// ParseSqlActiveDirectoryAdministratorID parses the specified Resource ID into a SqlActiveDirectoryAdministratorID
+func ParseSqlActiveDirectoryAdministratorID(input string) (*SqlActiveDirectoryAdministratorID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlActiveDirectoryAdministrator ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing %q as a SqlActiveDirectoryAdministrator ID: expected the Resource Provider %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlActiveDirectoryAdministratorID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlActiveDirectoryAdministrator ID: %+v", input, err)
+	}
+
+	if value, err := id.PopSegment("administrators"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlActiveDirectoryAdministrator ID: %+v", input, err)
+	} else if !strings.EqualFold(value, "activeDirectory") {
+		return nil, fmt.Errorf("Error parsing %q as a SqlActiveDirectoryAdministrator ID: expected the `administrators` segment to be %q but got %q", input, "activeDirectory", value)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlActiveDirectoryAdministrator ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlActiveDirectoryAdministratorID validates that the specified value is a SqlActiveDirectoryAdministrator ID
+func ValidateSqlActiveDirectoryAdministratorID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSqlActiveDirectoryAdministratorID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SqlActiveDirectoryAdministrator ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlDatabaseID is a parsed Resource ID for a SqlDatabase
+type SqlDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlDatabaseID returns a SqlDatabaseID comprised of the specified components
+func NewSqlDatabaseID(subscriptionId, resourceGroup, serverName, name string) SqlDatabaseID {
+	return SqlDatabaseID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SqlDatabase
+func (id SqlDatabaseID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlDatabaseID parses the specified Resource ID into a SqlDatabaseID
+func ParseSqlDatabaseID(input string) (*SqlDatabaseID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlDatabase ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing %q as a SqlDatabase ID: expected the Resource Provider %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlDatabaseID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlDatabase ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlDatabase ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlDatabase ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlDatabaseID validates that the specified value is a SqlDatabase ID
+func ValidateSqlDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSqlDatabaseID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SqlDatabase ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlElasticPoolID is a parsed Resource ID for a SqlElasticPool
+type SqlElasticPoolID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlElasticPoolID returns a SqlElasticPoolID comprised of the specified components
+func NewSqlElasticPoolID(subscriptionId, resourceGroup, serverName, name string) SqlElasticPoolID {
+	return SqlElasticPoolID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SqlElasticPool
+func (id SqlElasticPoolID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/elasticPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlElasticPoolID parses the specified Resource ID into a SqlElasticPoolID
+func ParseSqlElasticPoolID(input string) (*SqlElasticPoolID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlElasticPool ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing %q as a SqlElasticPool ID: expected the Resource Provider %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlElasticPoolID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlElasticPool ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("elasticPools"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlElasticPool ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlElasticPool ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlElasticPoolID validates that the specified value is a SqlElasticPool ID
+func ValidateSqlElasticPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSqlElasticPoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SqlElasticPool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SqlVirtualNetworkRuleID is a parsed Resource ID for a SqlVirtualNetworkRule
+type SqlVirtualNetworkRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlVirtualNetworkRuleID returns a SqlVirtualNetworkRuleID comprised of the specified components
+func NewSqlVirtualNetworkRuleID(subscriptionId, resourceGroup, serverName, name string) SqlVirtualNetworkRuleID {
+	return SqlVirtualNetworkRuleID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SqlVirtualNetworkRule
+func (id SqlVirtualNetworkRuleID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/virtualNetworkRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlVirtualNetworkRuleID parses the specified Resource ID into a SqlVirtualNetworkRuleID
+func ParseSqlVirtualNetworkRuleID(input string) (*SqlVirtualNetworkRuleID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Sql") {
+		return nil, fmt.Errorf("Error parsing %q as a SqlVirtualNetworkRule ID: expected the Resource Provider %q but got %q", input, "Microsoft.Sql", id.Provider)
+	}
+
+	resourceId := SqlVirtualNetworkRuleID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SqlVirtualNetworkRule ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSqlVirtualNetworkRuleID validates that the specified value is a SqlVirtualNetworkRule ID
+func ValidateSqlVirtualNetworkRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSqlVirtualNetworkRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SqlVirtualNetworkRule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
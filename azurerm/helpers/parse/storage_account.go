@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StorageAccountID is a parsed Resource ID for a StorageAccount
+type StorageAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewStorageAccountID returns a StorageAccountID comprised of the specified components
+func NewStorageAccountID(subscriptionId, resourceGroup, name string) StorageAccountID {
+	return StorageAccountID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this StorageAccount
+func (id StorageAccountID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseStorageAccountID parses the specified Resource ID into a StorageAccountID
+func ParseStorageAccountID(input string) (*StorageAccountID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a StorageAccount ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Storage") {
+		return nil, fmt.Errorf("Error parsing %q as a StorageAccount ID: expected the Resource Provider %q but got %q", input, "Microsoft.Storage", id.Provider)
+	}
+
+	resourceId := StorageAccountID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a StorageAccount ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a StorageAccount ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStorageAccountID validates that the specified value is a StorageAccount ID
+func ValidateStorageAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseStorageAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a StorageAccount ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
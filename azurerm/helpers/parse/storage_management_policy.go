@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// StorageManagementPolicyID is a parsed Resource ID for a StorageManagementPolicy
+type StorageManagementPolicyID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	StorageAccountName string
+	Name               string
+}
+
+// NewStorageManagementPolicyID returns a StorageManagementPolicyID comprised of the specified components
+func NewStorageManagementPolicyID(subscriptionId, resourceGroup, storageAccountName, name string) StorageManagementPolicyID {
+	return StorageManagementPolicyID{
+		SubscriptionID:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+}
+
+// ID returns the Resource ID for this StorageManagementPolicy
+func (id StorageManagementPolicyID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/managementPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.StorageAccountName, id.Name)
+}
+
+// ParseStorageManagementPolicyID parses the specified Resource ID into a StorageManagementPolicyID
+func ParseStorageManagementPolicyID(input string) (*StorageManagementPolicyID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a StorageManagementPolicy ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Storage") {
+		return nil, fmt.Errorf("Error parsing %q as a StorageManagementPolicy ID: expected the Resource Provider %q but got %q", input, "Microsoft.Storage", id.Provider)
+	}
+
+	resourceId := StorageManagementPolicyID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a StorageManagementPolicy ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("managementPolicies"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a StorageManagementPolicy ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a StorageManagementPolicy ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStorageManagementPolicyID validates that the specified value is a StorageManagementPolicy ID
+func ValidateStorageManagementPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseStorageManagementPolicyID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a StorageManagementPolicy ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// SubnetID is a parsed Resource ID for a Subnet
+type SubnetID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+// NewSubnetID returns a SubnetID comprised of the specified components
+func NewSubnetID(subscriptionId, resourceGroup, virtualNetworkName, name string) SubnetID {
+	return SubnetID{
+		SubscriptionID:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// ID returns the Resource ID for this Subnet
+func (id SubnetID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/subnets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ParseSubnetID parses the specified Resource ID into a SubnetID
+func ParseSubnetID(input string) (*SubnetID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Subnet ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a Subnet ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := SubnetID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Subnet ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("subnets"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Subnet ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Subnet ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSubnetID validates that the specified value is a Subnet ID
+func ValidateSubnetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSubnetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Subnet ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
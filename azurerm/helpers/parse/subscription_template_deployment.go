@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: the generator only supports Resource IDs within a Resource Group, as such this Resource ID is maintained by hand
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SubscriptionTemplateDeploymentID is a parsed Resource ID for a Template Deployment at the Subscription scope
+type SubscriptionTemplateDeploymentID struct {
+	SubscriptionID string
+	Name           string
+}
+
+// NewSubscriptionTemplateDeploymentID returns a SubscriptionTemplateDeploymentID comprised of the specified components
+func NewSubscriptionTemplateDeploymentID(subscriptionId, name string) SubscriptionTemplateDeploymentID {
+	return SubscriptionTemplateDeploymentID{
+		SubscriptionID: subscriptionId,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this SubscriptionTemplateDeployment
+func (id SubscriptionTemplateDeploymentID) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Resources/deployments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.Name)
+}
+
+// ParseSubscriptionTemplateDeploymentID parses the specified Resource ID into a SubscriptionTemplateDeploymentID
+func ParseSubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploymentID, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 6 {
+		return nil, fmt.Errorf("Error parsing %q as a SubscriptionTemplateDeployment ID: expected 6 segments but got %d", input, len(segments))
+	}
+
+	expected := map[int]string{
+		0: "subscriptions",
+		2: "providers",
+		3: "Microsoft.Resources",
+		4: "deployments",
+	}
+	for index, value := range expected {
+		if !strings.EqualFold(segments[index], value) {
+			return nil, fmt.Errorf("Error parsing %q as a SubscriptionTemplateDeployment ID: expected the segment %q but got %q", input, value, segments[index])
+		}
+	}
+
+	resourceId := SubscriptionTemplateDeploymentID{
+		SubscriptionID: segments[1],
+		Name:           segments[5],
+	}
+
+	if resourceId.SubscriptionID == "" || resourceId.Name == "" {
+		return nil, fmt.Errorf("Error parsing %q as a SubscriptionTemplateDeployment ID: the Subscription ID and Name must not be empty", input)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSubscriptionTemplateDeploymentID validates that the specified value is a SubscriptionTemplateDeployment ID
+func ValidateSubscriptionTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseSubscriptionTemplateDeploymentID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a SubscriptionTemplateDeployment ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
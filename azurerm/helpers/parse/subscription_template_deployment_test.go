@@ -0,0 +1,99 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestSubscriptionTemplateDeploymentIDFormatter(t *testing.T) {
+	actual := NewSubscriptionTemplateDeploymentID("12345678-1234-9876-4563-123456789012", "templatedeployment1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/templatedeployment1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseSubscriptionTemplateDeploymentID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *SubscriptionTemplateDeploymentID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing SubscriptionID Value",
+			Input:    "/subscriptions/",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name Value",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/",
+			Expected: nil,
+		},
+		{
+			Name:  "SubscriptionTemplateDeployment ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/templatedeployment1",
+			Expected: &SubscriptionTemplateDeploymentID{
+				SubscriptionID: "12345678-1234-9876-4563-123456789012",
+				Name:           "templatedeployment1",
+			},
+		},
+		{
+			Name:  "Upper-cased Segment Names",
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/Microsoft.Resources/DEPLOYMENTS/templatedeployment1",
+			Expected: &SubscriptionTemplateDeploymentID{
+				SubscriptionID: "12345678-1234-9876-4563-123456789012",
+				Name:           "templatedeployment1",
+			},
+		},
+		{
+			Name:     "Resource Group Template Deployment ID",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Resources/deployments/templatedeployment1",
+			Expected: nil,
+		},
+		{
+			Name:     "Additional Segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/templatedeployment1/additional/segment1",
+			Expected: nil,
+		},
+		{
+			Name:     "Different Resource Provider",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Different/deployments/templatedeployment1",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseSubscriptionTemplateDeploymentID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value: %+v", *actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
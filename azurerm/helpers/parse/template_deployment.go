@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// TemplateDeploymentID is a parsed Resource ID for a TemplateDeployment
+type TemplateDeploymentID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewTemplateDeploymentID returns a TemplateDeploymentID comprised of the specified components
+func NewTemplateDeploymentID(subscriptionId, resourceGroup, name string) TemplateDeploymentID {
+	return TemplateDeploymentID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this TemplateDeployment
+func (id TemplateDeploymentID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Resources/deployments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseTemplateDeploymentID parses the specified Resource ID into a TemplateDeploymentID
+func ParseTemplateDeploymentID(input string) (*TemplateDeploymentID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a TemplateDeployment ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Resources") {
+		return nil, fmt.Errorf("Error parsing %q as a TemplateDeployment ID: expected the Resource Provider %q but got %q", input, "Microsoft.Resources", id.Provider)
+	}
+
+	resourceId := TemplateDeploymentID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("deployments"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a TemplateDeployment ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a TemplateDeployment ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateTemplateDeploymentID validates that the specified value is a TemplateDeployment ID
+func ValidateTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseTemplateDeploymentID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a TemplateDeployment ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
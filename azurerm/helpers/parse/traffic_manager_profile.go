@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// TrafficManagerProfileID is a parsed Resource ID for a TrafficManagerProfile
+type TrafficManagerProfileID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewTrafficManagerProfileID returns a TrafficManagerProfileID comprised of the specified components
+func NewTrafficManagerProfileID(subscriptionId, resourceGroup, name string) TrafficManagerProfileID {
+	return TrafficManagerProfileID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this TrafficManagerProfile
+func (id TrafficManagerProfileID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseTrafficManagerProfileID parses the specified Resource ID into a TrafficManagerProfileID
+func ParseTrafficManagerProfileID(input string) (*TrafficManagerProfileID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a TrafficManagerProfile ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a TrafficManagerProfile ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := TrafficManagerProfileID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a TrafficManagerProfile ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a TrafficManagerProfile ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateTrafficManagerProfileID validates that the specified value is a TrafficManagerProfile ID
+func ValidateTrafficManagerProfileID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseTrafficManagerProfileID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a TrafficManagerProfile ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// UserAssignedIdentityID is a parsed Resource ID for a UserAssignedIdentity
+type UserAssignedIdentityID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewUserAssignedIdentityID returns a UserAssignedIdentityID comprised of the specified components
+func NewUserAssignedIdentityID(subscriptionId, resourceGroup, name string) UserAssignedIdentityID {
+	return UserAssignedIdentityID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this UserAssignedIdentity
+func (id UserAssignedIdentityID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseUserAssignedIdentityID parses the specified Resource ID into a UserAssignedIdentityID
+func ParseUserAssignedIdentityID(input string) (*UserAssignedIdentityID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a UserAssignedIdentity ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ManagedIdentity") {
+		return nil, fmt.Errorf("Error parsing %q as a UserAssignedIdentity ID: expected the Resource Provider %q but got %q", input, "Microsoft.ManagedIdentity", id.Provider)
+	}
+
+	resourceId := UserAssignedIdentityID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("userAssignedIdentities"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a UserAssignedIdentity ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a UserAssignedIdentity ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateUserAssignedIdentityID validates that the specified value is a UserAssignedIdentity ID
+func ValidateUserAssignedIdentityID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseUserAssignedIdentityID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a UserAssignedIdentity ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineID is a parsed Resource ID for a VirtualMachine
+type VirtualMachineID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualMachineID returns a VirtualMachineID comprised of the specified components
+func NewVirtualMachineID(subscriptionId, resourceGroup, name string) VirtualMachineID {
+	return VirtualMachineID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this VirtualMachine
+func (id VirtualMachineID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualMachineID parses the specified Resource ID into a VirtualMachineID
+func ParseVirtualMachineID(input string) (*VirtualMachineID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachine ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachine ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachine ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachine ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineID validates that the specified value is a VirtualMachine ID
+func ValidateVirtualMachineID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualMachineID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a VirtualMachine ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
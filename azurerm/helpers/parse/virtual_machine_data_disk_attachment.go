@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineDataDiskAttachmentID is a parsed Resource ID for a VirtualMachineDataDiskAttachment
+type VirtualMachineDataDiskAttachmentID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+// NewVirtualMachineDataDiskAttachmentID returns a VirtualMachineDataDiskAttachmentID comprised of the specified components
+func NewVirtualMachineDataDiskAttachmentID(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineDataDiskAttachmentID {
+	return VirtualMachineDataDiskAttachmentID{
+		SubscriptionID:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// ID returns the Resource ID for this VirtualMachineDataDiskAttachment
+func (id VirtualMachineDataDiskAttachmentID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/dataDisks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ParseVirtualMachineDataDiskAttachmentID parses the specified Resource ID into a VirtualMachineDataDiskAttachmentID
+func ParseVirtualMachineDataDiskAttachmentID(input string) (*VirtualMachineDataDiskAttachmentID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineDataDiskAttachment ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineDataDiskAttachment ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineDataDiskAttachmentID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineDataDiskAttachment ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("dataDisks"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineDataDiskAttachment ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineDataDiskAttachment ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineDataDiskAttachmentID validates that the specified value is a VirtualMachineDataDiskAttachment ID
+func ValidateVirtualMachineDataDiskAttachmentID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualMachineDataDiskAttachmentID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a VirtualMachineDataDiskAttachment ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
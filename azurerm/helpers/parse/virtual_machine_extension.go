@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualMachineExtensionID is a parsed Resource ID for a VirtualMachineExtension
+type VirtualMachineExtensionID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+// NewVirtualMachineExtensionID returns a VirtualMachineExtensionID comprised of the specified components
+func NewVirtualMachineExtensionID(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineExtensionID {
+	return VirtualMachineExtensionID{
+		SubscriptionID:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// ID returns the Resource ID for this VirtualMachineExtension
+func (id VirtualMachineExtensionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/extensions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ParseVirtualMachineExtensionID parses the specified Resource ID into a VirtualMachineExtensionID
+func ParseVirtualMachineExtensionID(input string) (*VirtualMachineExtensionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineExtension ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineExtension ID: expected the Resource Provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := VirtualMachineExtensionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineExtension ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("extensions"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineExtension ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualMachineExtension ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualMachineExtensionID validates that the specified value is a VirtualMachineExtension ID
+func ValidateVirtualMachineExtensionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualMachineExtensionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a VirtualMachineExtension ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkGatewayConnectionID is a parsed Resource ID for a VirtualNetworkGatewayConnection
+type VirtualNetworkGatewayConnectionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkGatewayConnectionID returns a VirtualNetworkGatewayConnectionID comprised of the specified components
+func NewVirtualNetworkGatewayConnectionID(subscriptionId, resourceGroup, name string) VirtualNetworkGatewayConnectionID {
+	return VirtualNetworkGatewayConnectionID{
+		SubscriptionID: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the Resource ID for this VirtualNetworkGatewayConnection
+func (id VirtualNetworkGatewayConnectionID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/connections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkGatewayConnectionID parses the specified Resource ID into a VirtualNetworkGatewayConnectionID
+func ParseVirtualNetworkGatewayConnectionID(input string) (*VirtualNetworkGatewayConnectionID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkGatewayConnection ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkGatewayConnection ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkGatewayConnectionID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("connections"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkGatewayConnection ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkGatewayConnection ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkGatewayConnectionID validates that the specified value is a VirtualNetworkGatewayConnection ID
+func ValidateVirtualNetworkGatewayConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualNetworkGatewayConnectionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a VirtualNetworkGatewayConnection ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
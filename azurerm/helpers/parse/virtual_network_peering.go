@@ -0,0 +1,80 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// VirtualNetworkPeeringID is a parsed Resource ID for a VirtualNetworkPeering
+type VirtualNetworkPeeringID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+// NewVirtualNetworkPeeringID returns a VirtualNetworkPeeringID comprised of the specified components
+func NewVirtualNetworkPeeringID(subscriptionId, resourceGroup, virtualNetworkName, name string) VirtualNetworkPeeringID {
+	return VirtualNetworkPeeringID{
+		SubscriptionID:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// ID returns the Resource ID for this VirtualNetworkPeering
+func (id VirtualNetworkPeeringID) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/virtualNetworkPeerings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionID, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ParseVirtualNetworkPeeringID parses the specified Resource ID into a VirtualNetworkPeeringID
+func ParseVirtualNetworkPeeringID(input string) (*VirtualNetworkPeeringID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkPeering ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkPeering ID: expected the Resource Provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := VirtualNetworkPeeringID{
+		SubscriptionID: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkPeering ID: %+v", input, err)
+	}
+
+	if resourceId.Name, err = id.PopSegment("virtualNetworkPeerings"); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkPeering ID: %+v", input, err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VirtualNetworkPeering ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateVirtualNetworkPeeringID validates that the specified value is a VirtualNetworkPeering ID
+func ValidateVirtualNetworkPeeringID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseVirtualNetworkPeeringID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a VirtualNetworkPeering ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
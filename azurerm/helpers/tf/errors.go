@@ -0,0 +1,13 @@
+package tf
+
+import "fmt"
+
+// ImportAsExistsError returns an error for a resource which already exists in Azure but isn't present in the
+// Terraform State - which needs to be imported into the State, rather than being silently adopted (and overwritten)
+func ImportAsExistsError(resourceName string, id string) error {
+	msg := "A resource with the ID %q already exists - to be managed via Terraform this resource needs to be imported into the State:\n\n" +
+		"  terraform import %s.<name> %s\n\n" +
+		"Please see the documentation for %q for more information. Alternatively this check can be disabled " +
+		"(for example when migrating existing resources) by setting `skip_existing_resource_check` to `true` in the Provider block."
+	return fmt.Errorf(msg, id, resourceName, id, resourceName)
+}
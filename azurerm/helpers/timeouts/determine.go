@@ -0,0 +1,45 @@
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
+)
+
+// ForCreate returns the context wrapped with the timeout for a Create operation
+//
+// The context for each operation is also associated with the correlation.Operation
+// being tracked for the ResourceData, such that each request sent using it is traced.
+//
+// The returned CancelFunc must be called once the operation has completed, to
+// release the resources associated with the context.
+func ForCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(correlation.WithOperation(ctx, d), d.Timeout(schema.TimeoutCreate))
+}
+
+// ForCreateUpdate returns the context wrapped with the timeout for a combined Create/Update operation
+//
+// This uses the Create timeout for new resources and the Update timeout for existing ones.
+func ForCreateUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	if d.IsNewResource() {
+		return ForCreate(ctx, d)
+	}
+
+	return ForUpdate(ctx, d)
+}
+
+// ForDelete returns the context wrapped with the timeout for a Delete operation
+func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(correlation.WithOperation(ctx, d), d.Timeout(schema.TimeoutDelete))
+}
+
+// ForRead returns the context wrapped with the timeout for a Read operation
+func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(correlation.WithOperation(ctx, d), d.Timeout(schema.TimeoutRead))
+}
+
+// ForUpdate returns the context wrapped with the timeout for an Update operation
+func ForUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(correlation.WithOperation(ctx, d), d.Timeout(schema.TimeoutUpdate))
+}
@@ -0,0 +1,86 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func testResourceData() *schema.ResourceData {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+	return r.Data(nil)
+}
+
+func TestTimeouts(t *testing.T) {
+	cases := []struct {
+		Name        string
+		NewResource bool
+		Func        func(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc)
+		Expected    time.Duration
+	}{
+		{
+			Name:     "Create",
+			Func:     ForCreate,
+			Expected: 90 * time.Minute,
+		},
+		{
+			Name:        "CreateUpdate for a new resource",
+			NewResource: true,
+			Func:        ForCreateUpdate,
+			Expected:    90 * time.Minute,
+		},
+		{
+			Name:     "CreateUpdate for an existing resource",
+			Func:     ForCreateUpdate,
+			Expected: 60 * time.Minute,
+		},
+		{
+			Name:     "Delete",
+			Func:     ForDelete,
+			Expected: 30 * time.Minute,
+		},
+		{
+			Name:     "Read",
+			Func:     ForRead,
+			Expected: 5 * time.Minute,
+		},
+		{
+			Name:     "Update",
+			Func:     ForUpdate,
+			Expected: 60 * time.Minute,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := testResourceData()
+		if v.NewResource {
+			d.MarkNewResource()
+		}
+
+		start := time.Now()
+		ctx, cancel := v.Func(context.Background(), d)
+		deadline, ok := ctx.Deadline()
+		cancel()
+
+		if !ok {
+			t.Fatalf("Expected %q to return a context with a deadline", v.Name)
+		}
+
+		actual := deadline.Sub(start)
+		if actual < v.Expected || actual > v.Expected+time.Minute {
+			t.Fatalf("Expected %q to have a deadline of ~%s but got %s", v.Name, v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var armTemplateSchemaRegex = regexp.MustCompile(`(?i)^https?://schema\.management\.azure\.com/schemas/[^/]+/(subscription|tenant|managementGroup)?DeploymentTemplate\.json#?$`)
+var armTemplateContentVersionRegex = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+\.[0-9]+$`)
+var armTemplateParameterReferenceRegex = regexp.MustCompile(`(?i)\bparameters\(\s*'([^']*)'\s*\)`)
+
+var armTemplateParameterTypes = []string{"array", "bool", "int", "object", "secureobject", "securestring", "string"}
+
+// ArmTemplateBody validates that the value is a valid ARM Template - checking the JSON structure, the `$schema`
+// and `contentVersion`, that each Parameter has a valid type (and that the default value matches that type and
+// the allowed values) and that each Parameter referenced in an expression is declared. This is intentionally
+// a subset of the validation performed by Azure, which can be done without making any requests.
+func ArmTemplateBody(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// the template is optional - when it's specified as a link
+	if v == "" {
+		return
+	}
+
+	template, err := parseArmTemplate(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid ARM Template: %+v", k, err))
+		return
+	}
+
+	for _, err := range validateArmTemplate(template) {
+		errors = append(errors, fmt.Errorf("%q is not a valid ARM Template: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+// ArmTemplateParameterValues validates the values specified for each of the Parameters within the ARM Template -
+// checking that the Parameters are declared in the Template, that each Parameter without a default value has a
+// value, and that the value is one of the allowed values. A nil value (e.g. a Key Vault reference) isn't validated.
+func ArmTemplateParameterValues(templateBody string, values map[string]interface{}) []error {
+	template, err := parseArmTemplate(templateBody)
+	if err != nil {
+		return []error{err}
+	}
+
+	parameters, _ := template["parameters"].(map[string]interface{})
+
+	declared := make(map[string]string)
+	for name := range parameters {
+		declared[strings.ToLower(name)] = name
+	}
+
+	specified := make(map[string]interface{})
+	errors := make([]error, 0)
+	for _, name := range armTemplateSortedKeys(values) {
+		if _, ok := declared[strings.ToLower(name)]; !ok {
+			errors = append(errors, fmt.Errorf("the parameter %q isn't declared in the template", name))
+			continue
+		}
+		specified[strings.ToLower(name)] = values[name]
+	}
+
+	for _, name := range armTemplateSortedKeys(parameters) {
+		parameter, ok := parameters[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		value, ok := specified[strings.ToLower(name)]
+		if !ok {
+			if _, hasDefault := parameter["defaultValue"]; !hasDefault {
+				errors = append(errors, fmt.Errorf("a value must be specified for the parameter %q since it has no default value", name))
+			}
+			continue
+		}
+
+		allowedValues, ok := parameter["allowedValues"].([]interface{})
+		if !ok || value == nil {
+			continue
+		}
+
+		parameterType, _ := parameter["type"].(string)
+		if strings.EqualFold(parameterType, "array") {
+			continue
+		}
+
+		if !armTemplateValueIsAllowed(value, allowedValues) {
+			errors = append(errors, fmt.Errorf("the value %v for the parameter %q isn't one of the allowed values %v", value, name, allowedValues))
+		}
+	}
+
+	return errors
+}
+
+func parseArmTemplate(input string) (map[string]interface{}, error) {
+	var template interface{}
+	if err := json.Unmarshal([]byte(input), &template); err != nil {
+		return nil, fmt.Errorf("the template isn't valid JSON: %+v", err)
+	}
+
+	output, ok := template.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the template must be a JSON object")
+	}
+
+	return output, nil
+}
+
+func validateArmTemplate(template map[string]interface{}) []error {
+	errors := make([]error, 0)
+
+	if v, ok := template["$schema"].(string); !ok {
+		errors = append(errors, fmt.Errorf("the `$schema` must be specified"))
+	} else if !armTemplateSchemaRegex.MatchString(v) {
+		errors = append(errors, fmt.Errorf("the `$schema` %q isn't a Deployment Template schema", v))
+	}
+
+	if v, ok := template["contentVersion"].(string); !ok {
+		errors = append(errors, fmt.Errorf("the `contentVersion` must be specified"))
+	} else if !armTemplateContentVersionRegex.MatchString(v) {
+		errors = append(errors, fmt.Errorf("the `contentVersion` %q must be in the format `1.0.0.0`", v))
+	}
+
+	if _, ok := template["resources"].([]interface{}); !ok {
+		errors = append(errors, fmt.Errorf("the `resources` must be specified as an array"))
+	}
+
+	for _, key := range []string{"parameters", "variables", "outputs"} {
+		if v, ok := template[key]; ok {
+			if _, isObject := v.(map[string]interface{}); !isObject {
+				errors = append(errors, fmt.Errorf("the `%s` must be an object", key))
+			}
+		}
+	}
+
+	parameters, _ := template["parameters"].(map[string]interface{})
+	for _, name := range armTemplateSortedKeys(parameters) {
+		for _, err := range validateArmTemplateParameter(parameters[name]) {
+			errors = append(errors, fmt.Errorf("the parameter %q is invalid: %+v", name, err))
+		}
+	}
+
+	declared := make(map[string]struct{})
+	for name := range parameters {
+		declared[strings.ToLower(name)] = struct{}{}
+	}
+
+	references := make(map[string]struct{})
+	for key, value := range template {
+		// user-defined functions have their own parameters
+		if key == "functions" {
+			continue
+		}
+
+		findArmTemplateParameterReferences(value, references)
+	}
+
+	for _, name := range armTemplateSortedKeys(references) {
+		if _, ok := declared[strings.ToLower(name)]; !ok {
+			errors = append(errors, fmt.Errorf("the parameter %q is referenced but isn't declared in the `parameters`", name))
+		}
+	}
+
+	return errors
+}
+
+func validateArmTemplateParameter(input interface{}) []error {
+	parameter, ok := input.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("the parameter must be an object")}
+	}
+
+	parameterType, ok := parameter["type"].(string)
+	if !ok {
+		return []error{fmt.Errorf("the `type` must be specified")}
+	}
+
+	valid := false
+	for _, v := range armTemplateParameterTypes {
+		if strings.EqualFold(v, parameterType) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return []error{fmt.Errorf("the `type` %q isn't supported - must be one of %s", parameterType, strings.Join(armTemplateParameterTypes, ", "))}
+	}
+
+	errors := make([]error, 0)
+
+	var allowedValues []interface{}
+	if v, ok := parameter["allowedValues"]; ok {
+		allowedValues, ok = v.([]interface{})
+		if !ok || len(allowedValues) == 0 {
+			errors = append(errors, fmt.Errorf("the `allowedValues` must be a non-empty array"))
+		}
+	}
+
+	for _, key := range []string{"minValue", "maxValue", "minLength", "maxLength"} {
+		if v, ok := parameter[key]; ok && !armTemplateValueIsInteger(v) {
+			errors = append(errors, fmt.Errorf("the `%s` must be an integer", key))
+		}
+	}
+
+	defaultValue, ok := parameter["defaultValue"]
+	if !ok || armTemplateValueIsExpression(defaultValue) {
+		return errors
+	}
+
+	if !armTemplateValueMatchesType(defaultValue, parameterType) {
+		errors = append(errors, fmt.Errorf("the `defaultValue` must be of type %q", parameterType))
+		return errors
+	}
+
+	if len(allowedValues) > 0 && !strings.EqualFold(parameterType, "array") && !armTemplateValueIsAllowed(defaultValue, allowedValues) {
+		errors = append(errors, fmt.Errorf("the `defaultValue` %v isn't one of the `allowedValues` %v", defaultValue, allowedValues))
+	}
+
+	return errors
+}
+
+// findArmTemplateParameterReferences finds the names of the Parameters referenced in expressions within the input
+func findArmTemplateParameterReferences(input interface{}, references map[string]struct{}) {
+	switch v := input.(type) {
+	case string:
+		if !armTemplateValueIsExpression(v) {
+			return
+		}
+
+		for _, match := range armTemplateParameterReferenceRegex.FindAllStringSubmatch(v, -1) {
+			references[match[1]] = struct{}{}
+		}
+
+	case []interface{}:
+		for _, item := range v {
+			findArmTemplateParameterReferences(item, references)
+		}
+
+	case map[string]interface{}:
+		// a nested Template has its own parameters
+		if _, ok := v["$schema"]; ok {
+			return
+		}
+
+		for _, item := range v {
+			findArmTemplateParameterReferences(item, references)
+		}
+	}
+}
+
+// armTemplateValueIsExpression determines whether the value is a Template Expression, e.g. `[parameters('name')]`
+// - where a value beginning with `[[` is an escaped literal
+func armTemplateValueIsExpression(input interface{}) bool {
+	v, ok := input.(string)
+	if !ok {
+		return false
+	}
+
+	return strings.HasPrefix(v, "[") && !strings.HasPrefix(v, "[[") && strings.HasSuffix(v, "]")
+}
+
+func armTemplateValueIsInteger(input interface{}) bool {
+	v, ok := input.(float64)
+	return ok && v == math.Trunc(v)
+}
+
+func armTemplateValueMatchesType(input interface{}, parameterType string) bool {
+	switch strings.ToLower(parameterType) {
+	case "string", "securestring":
+		_, ok := input.(string)
+		return ok
+	case "int":
+		return armTemplateValueIsInteger(input)
+	case "bool":
+		_, ok := input.(bool)
+		return ok
+	case "object", "secureobject":
+		_, ok := input.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := input.([]interface{})
+		return ok
+	}
+
+	return false
+}
+
+// armTemplateValueIsAllowed determines whether the value is one of the allowed values - values specified within
+// Terraform (for example in the `parameters` map) are strings, so these are also compared in their string form
+func armTemplateValueIsAllowed(value interface{}, allowedValues []interface{}) bool {
+	for _, allowed := range allowedValues {
+		if reflect.DeepEqual(value, allowed) || fmt.Sprint(value) == fmt.Sprint(allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func armTemplateSortedKeys(input interface{}) []string {
+	keys := make([]string, 0)
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	case map[string]struct{}:
+		for key := range v {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
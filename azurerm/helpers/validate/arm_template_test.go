@@ -0,0 +1,203 @@
+package validate
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestArmTemplateBody_Valid(t *testing.T) {
+	files, err := ioutil.ReadDir(filepath.Join("testdata", "arm_templates", "valid"))
+	if err != nil {
+		t.Fatalf("Error listing the valid templates: %+v", err)
+	}
+
+	for _, file := range files {
+		t.Run(file.Name(), func(t *testing.T) {
+			template, err := ioutil.ReadFile(filepath.Join("testdata", "arm_templates", "valid", file.Name()))
+			if err != nil {
+				t.Fatalf("Error reading %q: %+v", file.Name(), err)
+			}
+
+			_, errors := ArmTemplateBody(string(template), "template_body")
+			if len(errors) > 0 {
+				t.Fatalf("Expected %q to be valid but got %d errors: %+v", file.Name(), len(errors), errors)
+			}
+		})
+	}
+}
+
+func TestArmTemplateBody_Invalid(t *testing.T) {
+	// each invalid template must have an expected error, so that it's not passing for the wrong reason
+	expected := map[string]string{
+		"default_value_not_allowed.json":  "isn't one of the `allowedValues`",
+		"default_value_wrong_type.json":   "the `defaultValue` must be of type \"int\"",
+		"empty_allowed_values.json":       "the `allowedValues` must be a non-empty array",
+		"invalid_content_version.json":    "the `contentVersion` \"1.0\" must be in the format",
+		"invalid_json.json":               "isn't valid JSON",
+		"missing_parameter_type.json":     "the `type` must be specified",
+		"missing_resources.json":          "the `resources` must be specified",
+		"missing_schema.json":             "the `$schema` must be specified",
+		"non_integer_max_length.json":     "the `maxLength` must be an integer",
+		"not_an_object.json":              "must be a JSON object",
+		"parameters_file_schema.json":     "isn't a Deployment Template schema",
+		"parameters_not_an_object.json":   "the `parameters` must be an object",
+		"undeclared_parameter.json":       "the parameter \"location\" is referenced but isn't declared",
+		"unsupported_parameter_type.json": "the `type` \"integer\" isn't supported",
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join("testdata", "arm_templates", "invalid"))
+	if err != nil {
+		t.Fatalf("Error listing the invalid templates: %+v", err)
+	}
+
+	if len(files) != len(expected) {
+		t.Fatalf("Expected %d invalid templates but got %d", len(expected), len(files))
+	}
+
+	for _, file := range files {
+		t.Run(file.Name(), func(t *testing.T) {
+			expectedError, ok := expected[file.Name()]
+			if !ok {
+				t.Fatalf("No expected error is defined for %q", file.Name())
+			}
+
+			template, err := ioutil.ReadFile(filepath.Join("testdata", "arm_templates", "invalid", file.Name()))
+			if err != nil {
+				t.Fatalf("Error reading %q: %+v", file.Name(), err)
+			}
+
+			_, errors := ArmTemplateBody(string(template), "template_body")
+			if len(errors) == 0 {
+				t.Fatalf("Expected %q to be invalid but got no errors", file.Name())
+			}
+
+			for _, err := range errors {
+				if strings.Contains(err.Error(), expectedError) {
+					return
+				}
+			}
+
+			t.Fatalf("Expected an error containing %q for %q but got: %+v", expectedError, file.Name(), errors)
+		})
+	}
+}
+
+func TestArmTemplateBody_Empty(t *testing.T) {
+	if _, errors := ArmTemplateBody("", "template_body"); len(errors) > 0 {
+		t.Fatalf("Expected an empty template to be valid but got: %+v", errors)
+	}
+}
+
+func TestArmTemplateParameterValues(t *testing.T) {
+	template := `{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "name": {
+      "type": "string"
+    },
+    "storageAccountType": {
+      "type": "string",
+      "defaultValue": "Standard_LRS",
+      "allowedValues": ["Standard_LRS", "Standard_GRS"]
+    },
+    "instanceCount": {
+      "type": "int",
+      "defaultValue": 1,
+      "allowedValues": [1, 2, 3]
+    },
+    "zones": {
+      "type": "array",
+      "defaultValue": [],
+      "allowedValues": ["1", "2", "3"]
+    }
+  },
+  "resources": []
+}`
+
+	cases := []struct {
+		Name   string
+		Values map[string]interface{}
+		Errors int
+	}{
+		{
+			Name:   "No Values",
+			Values: map[string]interface{}{},
+			Errors: 1,
+		},
+		{
+			Name: "Required Value",
+			Values: map[string]interface{}{
+				"name": "example",
+			},
+			Errors: 0,
+		},
+		{
+			Name: "Names are Case Insensitive",
+			Values: map[string]interface{}{
+				"Name": "example",
+			},
+			Errors: 0,
+		},
+		{
+			Name: "Undeclared Parameter",
+			Values: map[string]interface{}{
+				"name":     "example",
+				"location": "westeurope",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Allowed Values",
+			Values: map[string]interface{}{
+				"name":               "example",
+				"storageAccountType": "Standard_GRS",
+				"instanceCount":      float64(2),
+			},
+			Errors: 0,
+		},
+		{
+			Name: "Allowed Integer as a String",
+			Values: map[string]interface{}{
+				"name":          "example",
+				"instanceCount": "3",
+			},
+			Errors: 0,
+		},
+		{
+			Name: "Value not Allowed",
+			Values: map[string]interface{}{
+				"name":               "example",
+				"storageAccountType": "Premium_LRS",
+				"instanceCount":      "4",
+			},
+			Errors: 2,
+		},
+		{
+			Name: "Array values aren't compared",
+			Values: map[string]interface{}{
+				"name":  "example",
+				"zones": []interface{}{"1", "2"},
+			},
+			Errors: 0,
+		},
+		{
+			Name: "Reference",
+			Values: map[string]interface{}{
+				"name": nil,
+			},
+			Errors: 0,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		errors := ArmTemplateParameterValues(template, v.Values)
+		if len(errors) != v.Errors {
+			t.Fatalf("Expected %d errors but got %d: %+v", v.Errors, len(errors), errors)
+		}
+	}
+}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"regexp"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/parse"
 )
 
 func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error) {
@@ -23,9 +23,8 @@ func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error)
 	return resGroup, name, nil
 }
 
-func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveLoadBalancerById(ctx context.Context, loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+	client := meta.(*ArmClient).network().loadBalancerClient
 
 	resGroup, name, err := resourceGroupAndLBNameFromId(loadBalancerId)
 	if err != nil {
@@ -146,23 +145,18 @@ func validateLoadBalancerPrivateIpAddressAllocation(v interface{}, _ string) (wa
 	return warnings, errors
 }
 
-// sets the loadbalancer_id in the ResourceData from the sub resources full id
-func loadBalancerSubResourceStateImporter(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	r, err := regexp.Compile(`.+\/loadBalancers\/.+?\/`)
-	if err != nil {
-		return nil, err
-	}
-
-	lbID := strings.TrimSuffix(r.FindString(d.Id()), "/")
-	parsed, err := parseAzureResourceID(lbID)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse loadbalancer id from %s", d.Id())
-	}
+// loadBalancerSubResourceImporter validates the ID of the Load Balancer sub resource being imported using
+// the specified parser, and then sets the loadbalancer_id in the ResourceData from the parsed Load Balancer ID
+func loadBalancerSubResourceImporter(parser func(input string) (*parse.LoadBalancerID, error)) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			lbId, err := parser(d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("The ID %q isn't valid for this Resource Type: %+v", d.Id(), err)
+			}
 
-	if parsed.Path["loadBalancers"] == "" {
-		return nil, fmt.Errorf("parsed ID is invalid")
+			d.Set("loadbalancer_id", lbId.ID())
+			return []*schema.ResourceData{d}, nil
+		},
 	}
-
-	d.Set("loadbalancer_id", lbID)
-	return []*schema.ResourceData{d}, nil
 }
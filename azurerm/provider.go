@@ -1,16 +1,23 @@
 package azurerm
 
 import (
+	"context"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 )
 
@@ -36,6 +43,19 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+			},
+
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -48,6 +68,18 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
 			},
 
+			"metadata_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_HOST", ""),
+			},
+
+			"environment_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT_FILE", ""),
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -59,6 +91,42 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"resource_provider_registrations": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceProviderRegistrationsAll),
+				ValidateFunc: validation.StringInSlice([]string{
+					resourceProviderRegistrationsAll,
+					resourceProviderRegistrationsUsed,
+				}, false),
+			},
+
+			"additional_resource_providers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+
+			"excluded_resource_providers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+
+			"skip_existing_resource_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_EXISTING_RESOURCE_CHECK", false),
+			},
 			"use_msi": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -69,6 +137,62 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
 			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", azure.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_retry_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRY_BACKOFF", int(azure.DefaultMaxRetryBackoff/time.Second)),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"max_concurrent_requests": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reads": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"writes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateAzureRMTags,
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -108,6 +232,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_public_ips":                            dataSourceArmPublicIPs(),
 			"azurerm_recovery_services_vault":               dataSourceArmRecoveryServicesVault(),
 			"azurerm_resource_group":                        dataSourceArmResourceGroup(),
+			"azurerm_resources":                             dataSourceArmResources(),
 			"azurerm_role_definition":                       dataSourceArmRoleDefinition(),
 			"azurerm_route_table":                           dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":              dataSourceArmSchedulerJobCollection(),
@@ -286,12 +411,16 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
 			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
 			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
+			"azurerm_storage_management_policy":                                              resourceArmStorageManagementPolicy(),
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
+			"azurerm_storage_share_directory":                                                resourceArmStorageShareDirectory(),
+			"azurerm_storage_share_file":                                                     resourceArmStorageShareFile(),
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_table":                                                          resourceArmStorageTable(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
+			"azurerm_subscription_template_deployment":                                       resourceArmSubscriptionTemplateDeployment(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
@@ -307,6 +436,14 @@ func Provider() terraform.ResourceProvider {
 		},
 	}
 
+	// each Create, Read, Update & Delete is tracked as an Operation, so any errors include the IDs needed to trace them
+	for _, r := range p.DataSourcesMap {
+		correlation.Track(r)
+	}
+	for _, r := range p.ResourcesMap {
+		correlation.Track(r)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -314,32 +451,54 @@ func Provider() terraform.ResourceProvider {
 
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
-		builder := &authentication.Builder{
-			SubscriptionID: d.Get("subscription_id").(string),
-			ClientID:       d.Get("client_id").(string),
-			ClientSecret:   d.Get("client_secret").(string),
-			TenantID:       d.Get("tenant_id").(string),
-			Environment:    d.Get("environment").(string),
-			MsiEndpoint:    d.Get("msi_endpoint").(string),
+		builder := authentication.Builder{
+			SubscriptionID:     d.Get("subscription_id").(string),
+			ClientID:           d.Get("client_id").(string),
+			ClientSecret:       d.Get("client_secret").(string),
+			ClientCertPath:     d.Get("client_certificate_path").(string),
+			ClientCertPassword: d.Get("client_certificate_password").(string),
+			TenantID:           d.Get("tenant_id").(string),
+			Environment:        d.Get("environment").(string),
+			MsiEndpoint:        d.Get("msi_endpoint").(string),
 
 			// Feature Toggles
+			SupportsClientCertAuth:         true,
 			SupportsClientSecretAuth:       true,
 			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
 			SupportsAzureCliToken:          true,
 		}
 
-		config, err := builder.Build()
+		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+		options := armClientOptions{
+			skipProviderRegistration: skipProviderRegistration,
+			retryPolicy: azure.RetryPolicy{
+				MaxRetries: d.Get("max_retries").(int),
+				MaxBackoff: time.Duration(d.Get("max_retry_backoff").(int)) * time.Second,
+			},
+			requestLimiter:    azure.NewRequestLimiter(expandProviderConcurrencyLimits(d.Get("max_concurrent_requests").([]interface{}))),
+			storageUseAzureAD: d.Get("storage_use_azuread").(bool),
+		}
+
+		config, tokenProvider, err := buildAuthenticationConfig(builder)
 		if err != nil {
 			return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
 		}
+		options.tokenProvider = tokenProvider
 
-		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration)
+		customEnvironment, err := loadCustomEnvironment(p.StopContext(), d, azure.BuildSender(options.retryPolicy, options.requestLimiter))
+		if err != nil {
+			return nil, err
+		}
+		options.customEnvironment = customEnvironment
+
+		client, err := getArmClient(config, options)
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = expandProviderDefaultTags(d.Get("default_tags").([]interface{}))
+		client.requireResourcesToBeImported = !d.Get("skip_existing_resource_check").(bool)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -347,12 +506,25 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil
 		}
 
+		registrationMode := d.Get("resource_provider_registrations").(string)
+		additionalResourceProviders := expandProviderResourceProviders(d.Get("additional_resource_providers").(*schema.Set))
+		excludedResourceProviders := expandProviderResourceProviders(d.Get("excluded_resource_providers").(*schema.Set))
+
+		// Resource Providers are registered when a request fails because they're not registered (and when only those
+		// which are used are registered, the first time a request is made to them) - the registrar has its own client
+		// (rather than the shared Resources client) so that this isn't recursive
+		if !skipProviderRegistration {
+			registrationClient := resources.NewProvidersClientWithBaseURI(client.resourceManagerEndpoint, client.subscriptionId)
+			client.configureClient(&registrationClient.Client, client.resourceManagerAuth)
+			client.resourceProviderRegistrar = newResourceProviderRegistrar(registrationClient, excludedResourceProviders, registrationMode == resourceProviderRegistrationsUsed)
+		}
+
 		skipCredentialsValidation := d.Get("skip_credentials_validation").(bool)
 		if !skipCredentialsValidation {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
-			providerList, err := client.providersClient.List(ctx, nil, "")
+			providerList, err := client.resources().providersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
@@ -361,9 +533,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 			if !skipProviderRegistration {
 				availableResourceProviders := providerList.Values()
-				requiredResourceProviders := requiredResourceProviders()
+				requiredResourceProviders := resourceProvidersForRegistration(availableResourceProviders, registrationMode, additionalResourceProviders, excludedResourceProviders)
 
-				err := ensureResourceProvidersAreRegistered(ctx, client.providersClient, availableResourceProviders, requiredResourceProviders)
+				if client.resourceProviderRegistrar != nil {
+					client.resourceProviderRegistrar.seed(availableResourceProviders)
+				}
+
+				err := ensureResourceProvidersAreRegistered(ctx, client.resources().providersClient, availableResourceProviders, requiredResourceProviders)
 				if err != nil {
 					return nil, fmt.Errorf("Error ensuring Resource Providers are registered: %s", err)
 				}
@@ -374,6 +550,50 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	}
 }
 
+// loadCustomEnvironment loads the Environment from either the `metadata_host` or the `environment_file`
+// if one's specified - otherwise the `environment` is one of the Environments built into go-autorest
+func loadCustomEnvironment(ctx context.Context, d *schema.ResourceData, sender autorest.Sender) (*azure.Environment, error) {
+	metadataHost := d.Get("metadata_host").(string)
+	environmentFile := d.Get("environment_file").(string)
+
+	if metadataHost != "" && environmentFile != "" {
+		return nil, fmt.Errorf("Only one of `metadata_host` and `environment_file` can be specified")
+	}
+
+	if metadataHost != "" {
+		return azure.LoadEnvironmentFromMetadataHost(ctx, sender, metadataHost)
+	}
+
+	if environmentFile != "" {
+		return azure.LoadEnvironmentFromFile(environmentFile)
+	}
+
+	return nil, nil
+}
+
+// expandProviderConcurrencyLimits expands the `max_concurrent_requests` block - where a limit of 0 (or
+// the block being omitted) means the number of concurrent requests isn't limited
+func expandProviderConcurrencyLimits(input []interface{}) azure.ConcurrencyLimits {
+	if len(input) == 0 || input[0] == nil {
+		return azure.ConcurrencyLimits{}
+	}
+
+	v := input[0].(map[string]interface{})
+	return azure.ConcurrencyLimits{
+		MaxReads:  v["reads"].(int),
+		MaxWrites: v["writes"].(int),
+	}
+}
+
+// expandProviderResourceProviders expands the `additional_resource_providers` and `excluded_resource_providers`
+func expandProviderResourceProviders(input *schema.Set) []string {
+	output := make([]string, 0)
+	for _, v := range input.List() {
+		output = append(output, v.(string))
+	}
+	return output
+}
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = mutexkv.NewMutexKV()
 
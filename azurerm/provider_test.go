@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -32,6 +33,36 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProvider_timeouts(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+
+	for name, resource := range provider.ResourcesMap {
+		if resource.Timeouts == nil {
+			t.Errorf("Resource %q has no Timeouts defined", name)
+			continue
+		}
+
+		if resource.Create != nil && resource.Timeouts.Create == nil {
+			t.Errorf("Resource %q defines a Create function but no Create Timeout", name)
+		}
+		if resource.Read != nil && resource.Timeouts.Read == nil {
+			t.Errorf("Resource %q defines a Read function but no Read Timeout", name)
+		}
+		if resource.Update != nil && resource.Timeouts.Update == nil {
+			t.Errorf("Resource %q defines an Update function but no Update Timeout", name)
+		}
+		if resource.Delete != nil && resource.Timeouts.Delete == nil {
+			t.Errorf("Resource %q defines a Delete function but no Delete Timeout", name)
+		}
+	}
+
+	for name, dataSource := range provider.DataSourcesMap {
+		if dataSource.Timeouts == nil || dataSource.Timeouts.Read == nil {
+			t.Errorf("Data Source %q has no Read Timeout defined", name)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	variables := []string{
 		"ARM_CLIENT_ID",
@@ -58,6 +89,11 @@ func testAltLocation() string {
 	return os.Getenv("ARM_TEST_LOCATION_ALT")
 }
 
+func testRequiresImportError(resourceName string) *regexp.Regexp {
+	message := "to be managed via Terraform this resource needs to be imported into the State:\\s+terraform import %s\\.<name>"
+	return regexp.MustCompile(fmt.Sprintf(message, regexp.QuoteMeta(resourceName)))
+}
+
 func testArmEnvironmentName() string {
 	envName, exists := os.LookupEnv("ARM_ENVIRONMENT")
 	if !exists {
@@ -1,10 +1,18 @@
 package azurerm
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
 )
 
@@ -76,3 +84,283 @@ func ensureResourceProvidersAreRegistered(ctx context.Context, client resources.
 
 	return nil
 }
+
+const (
+	// resourceProviderRegistrationsAll registers all of the Resource Providers used by the AzureRM Provider
+	// (in addition to any `additional_resource_providers`) when the Provider is configured
+	resourceProviderRegistrationsAll = "all"
+
+	// resourceProviderRegistrationsUsed registers the `additional_resource_providers` when the Provider is
+	// configured - and each other Resource Provider the first time it's used by a resource or data source
+	resourceProviderRegistrationsUsed = "used"
+)
+
+// resourceProvidersForRegistration returns the Resource Providers which should be registered when the Provider is
+// configured - where the casing of each namespace is taken from the available Resource Providers, since these are
+// compared case-sensitively when determining which Resource Providers require registration
+func resourceProvidersForRegistration(availableRPs []resources.Provider, mode string, additional []string, excluded []string) map[string]struct{} {
+	namespaces := make([]string, 0)
+	if mode == resourceProviderRegistrationsAll {
+		for namespace := range requiredResourceProviders() {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	namespaces = append(namespaces, additional...)
+
+	output := make(map[string]struct{})
+	for _, namespace := range namespaces {
+		if resourceProviderInList(namespace, excluded) {
+			continue
+		}
+
+		for _, rp := range availableRPs {
+			if rp.Namespace != nil && strings.EqualFold(*rp.Namespace, namespace) {
+				namespace = *rp.Namespace
+				break
+			}
+		}
+
+		output[namespace] = struct{}{}
+	}
+
+	return output
+}
+
+func resourceProviderInList(namespace string, list []string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+const resourceProviderRegistrationPollInterval = 10 * time.Second
+
+// resourceProviderRegistrar registers the Resource Providers used by the Provider on demand - either the first time
+// a request is made to each (such that only the Resource Providers which are used in the configuration are
+// registered), or when a request fails because the Resource Provider isn't registered - in which case the request
+// is sent again once it's been registered
+type resourceProviderRegistrar struct {
+	client   resources.ProvidersClient
+	excluded []string
+
+	// registerOnFirstUse registers each Resource Provider before the first request is made to it, rather than
+	// only when a request fails because the Resource Provider isn't registered
+	registerOnFirstUse bool
+
+	lock sync.Mutex
+	// registrations are keyed by the lower-cased namespace, and are closed once the registration has completed
+	registrations map[string]chan struct{}
+}
+
+func newResourceProviderRegistrar(client resources.ProvidersClient, excluded []string, registerOnFirstUse bool) *resourceProviderRegistrar {
+	return &resourceProviderRegistrar{
+		client:             client,
+		excluded:           excluded,
+		registerOnFirstUse: registerOnFirstUse,
+		registrations:      make(map[string]chan struct{}),
+	}
+}
+
+// seed records the Resource Providers which are already registered, to avoid unnecessary requests
+func (r *resourceProviderRegistrar) seed(availableRPs []resources.Provider) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, rp := range availableRPs {
+		if rp.Namespace == nil || rp.RegistrationState == nil || !strings.EqualFold(*rp.RegistrationState, "Registered") {
+			continue
+		}
+
+		key := strings.ToLower(*rp.Namespace)
+		if _, exists := r.registrations[key]; exists {
+			continue
+		}
+
+		done := make(chan struct{})
+		close(done)
+		r.registrations[key] = done
+	}
+}
+
+// withRegistration returns a SendDecorator which ensures the Resource Provider for the request is registered - either
+// before the request is sent (when registering on first use), or when the request fails with the
+// `MissingSubscriptionRegistration` error, in which case the request is sent again once it's been registered.
+// Failing to register the Resource Provider isn't fatal, since the principal may not have permission to do so -
+// in which case the `MissingSubscriptionRegistration` error is returned.
+func (r *resourceProviderRegistrar) withRegistration() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if r.registerOnFirstUse {
+				if namespace := resourceProviderNamespaceFromPath(req.URL.Path); namespace != "" {
+					if err := r.ensureRegistered(req.Context(), namespace); err != nil {
+						log.Printf("[WARN] Unable to register Resource Provider %q: %+v", namespace, err)
+					}
+				}
+			}
+
+			rr := autorest.NewRetriableRequest(req)
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(rr.Request())
+			if err != nil {
+				return resp, err
+			}
+
+			namespace := missingResourceProviderRegistration(resp)
+			if namespace == "" || resourceProviderInList(namespace, r.excluded) {
+				return resp, err
+			}
+
+			// the Resource Provider may have been registered previously (e.g. when the Provider was configured),
+			// however since it's not registered now it needs registering again
+			r.forget(namespace)
+			if regErr := r.ensureRegistered(req.Context(), namespace); regErr != nil {
+				log.Printf("[WARN] Unable to register Resource Provider %q: %+v", namespace, regErr)
+				return resp, err
+			}
+
+			log.Printf("[DEBUG] Registered Resource Provider %q - sending the request again", namespace)
+			autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			return s.Do(rr.Request())
+		})
+	}
+}
+
+// forget removes a completed registration for the Resource Provider, such that it's registered again
+func (r *resourceProviderRegistrar) forget(namespace string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := strings.ToLower(namespace)
+	if done, exists := r.registrations[key]; exists {
+		select {
+		case <-done:
+			delete(r.registrations, key)
+		default:
+			// the Resource Provider is being registered by another request
+		}
+	}
+}
+
+// missingResourceProviderRegistration returns the namespace of the Resource Provider when the response is the
+// `MissingSubscriptionRegistration` error (returned when the Resource Provider isn't registered on the Subscription)
+// - or an empty string otherwise. The body is read, so it's replaced to allow the response to be unmarshalled.
+func missingResourceProviderRegistration(resp *http.Response) string {
+	if resp == nil || resp.StatusCode != http.StatusConflict || resp.Body == nil {
+		return ""
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var payload struct {
+		Error struct {
+			Code    string `json:"code"`
+			Details []struct {
+				Target string `json:"target"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || payload.Error.Code != "MissingSubscriptionRegistration" {
+		return ""
+	}
+
+	for _, detail := range payload.Error.Details {
+		if detail.Target != "" {
+			return detail.Target
+		}
+	}
+
+	if resp.Request != nil && resp.Request.URL != nil {
+		return resourceProviderNamespaceFromPath(resp.Request.URL.Path)
+	}
+
+	return ""
+}
+
+func (r *resourceProviderRegistrar) ensureRegistered(ctx context.Context, namespace string) error {
+	if resourceProviderInList(namespace, r.excluded) {
+		return nil
+	}
+
+	key := strings.ToLower(namespace)
+
+	r.lock.Lock()
+	done, exists := r.registrations[key]
+	if !exists {
+		done = make(chan struct{})
+		r.registrations[key] = done
+	}
+	r.lock.Unlock()
+
+	// another request is registering (or has registered) this Resource Provider
+	if exists {
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	defer close(done)
+
+	log.Printf("[DEBUG] Registering Resource Provider %q..", namespace)
+	if _, err := r.client.Register(ctx, namespace); err != nil {
+		return err
+	}
+
+	// registration is asynchronous - and requests made to the Resource Provider fail until it's completed
+	for {
+		provider, err := r.client.Get(ctx, namespace, "")
+		if err != nil {
+			return err
+		}
+
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "Registered") {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Waiting for Resource Provider %q to be registered..", namespace)
+		select {
+		case <-time.After(resourceProviderRegistrationPollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// resourceProviderNamespaceFromPath returns the namespace of the Resource Provider for the specified request
+// path, e.g. `Microsoft.Network` for a Virtual Network - or an empty string if the path isn't for a resource.
+// Since extension resources are nested within another resource, the last Resource Provider in the path is used.
+func resourceProviderNamespaceFromPath(path string) string {
+	namespace := ""
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+2 < len(segments); i++ {
+		if !strings.EqualFold(segments[i], "providers") {
+			continue
+		}
+
+		// requests to register the Resource Provider itself (`/providers/{namespace}/register`) are excluded
+		if next := segments[i+2]; strings.EqualFold(next, "register") || strings.EqualFold(next, "unregister") {
+			return ""
+		}
+
+		namespace = segments[i+1]
+	}
+
+	return namespace
+}
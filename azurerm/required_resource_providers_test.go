@@ -3,8 +3,11 @@ package azurerm
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
@@ -14,12 +17,15 @@ func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 	}
 
 	// this test intentionally checks all the RP's are registered - so this is intentional
-	armClient, err := getArmClient(config, true)
+	armClient, err := getArmClient(config, armClientOptions{
+		skipProviderRegistration: true,
+		retryPolicy:              azure.DefaultRetryPolicy(),
+	})
 	if err != nil {
 		t.Fatalf("Error building ARM Client: %+v", err)
 	}
 
-	client := armClient.providersClient
+	client := armClient.resources().providersClient
 	ctx := testAccProvider.StopContext()
 	providerList, err := client.List(ctx, nil, "")
 	if err != nil {
@@ -48,3 +54,126 @@ func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(stillRequiringRegistration), spew.Sprint(stillRequiringRegistration))
 	}
 }
+
+func TestResourceProvidersForRegistration(t *testing.T) {
+	availableResourceProviders := []resources.Provider{
+		{
+			Namespace: utils.String("Microsoft.Network"),
+		},
+		{
+			Namespace: utils.String("Microsoft.DataFactory"),
+		},
+		{
+			Namespace: utils.String("microsoft.insights"),
+		},
+	}
+
+	testCases := []struct {
+		Name       string
+		Mode       string
+		Additional []string
+		Excluded   []string
+		Expected   []string
+		Unexpected []string
+	}{
+		{
+			Name:       "All",
+			Mode:       resourceProviderRegistrationsAll,
+			Expected:   []string{"Microsoft.Network", "Microsoft.Storage", "microsoft.insights"},
+			Unexpected: []string{"Microsoft.DataFactory"},
+		},
+		{
+			Name:       "All with Additional",
+			Mode:       resourceProviderRegistrationsAll,
+			Additional: []string{"microsoft.datafactory"},
+			Expected:   []string{"Microsoft.Network", "Microsoft.DataFactory"},
+		},
+		{
+			Name:       "All with Excluded",
+			Mode:       resourceProviderRegistrationsAll,
+			Excluded:   []string{"MICROSOFT.NETWORK", "Microsoft.Insights"},
+			Expected:   []string{"Microsoft.Storage"},
+			Unexpected: []string{"Microsoft.Network", "microsoft.insights"},
+		},
+		{
+			Name:       "Used",
+			Mode:       resourceProviderRegistrationsUsed,
+			Unexpected: []string{"Microsoft.Network", "Microsoft.Storage"},
+		},
+		{
+			Name:       "Used with Additional and Excluded",
+			Mode:       resourceProviderRegistrationsUsed,
+			Additional: []string{"Microsoft.DataFactory", "Microsoft.Network"},
+			Excluded:   []string{"Microsoft.Network"},
+			Expected:   []string{"Microsoft.DataFactory"},
+			Unexpected: []string{"Microsoft.Network", "Microsoft.Storage"},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := resourceProvidersForRegistration(availableResourceProviders, v.Mode, v.Additional, v.Excluded)
+
+		for _, namespace := range v.Expected {
+			if _, ok := actual[namespace]; !ok {
+				t.Fatalf("Expected %q to be registered but it wasn't: %s", namespace, spew.Sprint(actual))
+			}
+		}
+
+		for _, namespace := range v.Unexpected {
+			if _, ok := actual[namespace]; ok {
+				t.Fatalf("Expected %q not to be registered but it was: %s", namespace, spew.Sprint(actual))
+			}
+		}
+
+		if v.Mode == resourceProviderRegistrationsUsed && len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d Resource Providers to be registered but got %d: %s", len(v.Expected), len(actual), spew.Sprint(actual))
+		}
+	}
+}
+
+func TestResourceProviderNamespaceFromPath(t *testing.T) {
+	testCases := []struct {
+		Path     string
+		Expected string
+	}{
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers",
+			Expected: "",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1",
+			Expected: "",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/register",
+			Expected: "",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network",
+			Expected: "",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: "Microsoft.Network",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Web/sites",
+			Expected: "Microsoft.Web",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/providers/microsoft.insights/diagnosticSettings/setting1",
+			Expected: "microsoft.insights",
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Path)
+
+		actual := resourceProviderNamespaceFromPath(v.Path)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
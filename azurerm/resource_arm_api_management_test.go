@@ -97,7 +97,7 @@ func TestAccAzureRMApiManagement_complete(t *testing.T) {
 }
 
 func testCheckAzureRMApiManagementDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+	conn := testAccProvider.Meta().(*ArmClient).apiManagement().apiManagementServiceClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_api_management" {
@@ -137,7 +137,7 @@ func testCheckAzureRMApiManagementExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Api Management: %s", apiMangementName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).apiManagementServiceClient
+		conn := testAccProvider.Meta().(*ArmClient).apiManagement().apiManagementServiceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, apiMangementName)
 		if err != nil {
@@ -29,6 +29,31 @@ func TestAccAzureRMAppServiceActiveSlot_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppServiceActiveSlot_requiresImport(t *testing.T) {
+	resourceName := "azurerm_app_service_active_slot.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// Destroy actually does nothing so we just return nil
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceActiveSlot_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "app_service_slot_name", fmt.Sprintf("acctestASSlot-%d", ri)),
+				),
+			},
+			{
+				Config:      testAccAzureRMAppServiceActiveSlot_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_app_service_active_slot"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMAppServiceActiveSlot_update(t *testing.T) {
 	resourceName := "azurerm_app_service_active_slot.test"
 	ri := acctest.RandInt()
@@ -98,6 +123,19 @@ resource "azurerm_app_service_active_slot" "test" {
 `, rInt, location, rInt, rInt, rInt)
 }
 
+func testAccAzureRMAppServiceActiveSlot_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMAppServiceActiveSlot_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_active_slot" "import" {
+  resource_group_name   = "${azurerm_app_service_active_slot.test.resource_group_name}"
+  app_service_name      = "${azurerm_app_service_active_slot.test.app_service_name}"
+  app_service_slot_name = "${azurerm_app_service_active_slot.test.app_service_slot_name}"
+}
+`, template)
+}
+
 func testAccAzureRMAppServiceActiveSlot_update(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
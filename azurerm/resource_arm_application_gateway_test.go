@@ -26,7 +26,7 @@ func testSweepApplicationGateways(region string) error {
 		return err
 	}
 
-	client := (*armClient).applicationGatewayClient
+	client := (*armClient).network().applicationGatewayClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Application Gateways..")
@@ -278,7 +278,7 @@ func testCheckAzureRMApplicationGatewayExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for Application Gateway: %q", gatewayName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		client := testAccProvider.Meta().(*ArmClient).network().applicationGatewayClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, gatewayName)
@@ -295,7 +295,7 @@ func testCheckAzureRMApplicationGatewayExists(name string) resource.TestCheckFun
 }
 
 func testCheckAzureRMApplicationGatewayDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+	client := testAccProvider.Meta().(*ArmClient).network().applicationGatewayClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -91,7 +91,7 @@ func testCheckAzureRMApplicationSecurityGroupDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).applicationSecurityGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).network().applicationSecurityGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -124,7 +124,7 @@ func testCheckAzureRMApplicationSecurityGroupExists(name string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for Application Security Group: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).applicationSecurityGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).network().applicationSecurityGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
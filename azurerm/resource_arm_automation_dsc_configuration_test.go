@@ -40,7 +40,7 @@ func TestAccAzureRMAutomationDscConfiguration_basic(t *testing.T) {
 }
 
 func testCheckAzureRMAutomationDscConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).automationDscConfigurationClient
+	conn := testAccProvider.Meta().(*ArmClient).automation().automationDscConfigurationClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -90,7 +90,7 @@ func testCheckAzureRMAutomationDscConfigurationExists(name string) resource.Test
 			return fmt.Errorf("Bad: no resource group found in state for Automation Dsc Configuration: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).automationDscConfigurationClient
+		conn := testAccProvider.Meta().(*ArmClient).automation().automationDscConfigurationClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, accName, name)
@@ -37,7 +37,7 @@ func TestAccAzureRMAutomationModule_basic(t *testing.T) {
 }
 
 func testCheckAzureRMAutomationModuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).automationModuleClient
+	conn := testAccProvider.Meta().(*ArmClient).automation().automationModuleClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -87,7 +87,7 @@ func testCheckAzureRMAutomationModuleExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Automation Module: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).automationModuleClient
+		conn := testAccProvider.Meta().(*ArmClient).automation().automationModuleClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, accName, name)
@@ -167,7 +167,7 @@ func testCheckAzureRMAvailabilitySetExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", availSetName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).availSetClient
+		client := testAccProvider.Meta().(*ArmClient).compute().availSetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, availSetName)
 		if err != nil {
@@ -196,7 +196,7 @@ func testCheckAzureRMAvailabilitySetDisappears(name string) resource.TestCheckFu
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", availSetName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).availSetClient
+		client := testAccProvider.Meta().(*ArmClient).compute().availSetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Delete(ctx, resourceGroup, availSetName)
 		if err != nil {
@@ -218,7 +218,7 @@ func testCheckAzureRMAvailabilitySetDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).availSetClient
+		client := testAccProvider.Meta().(*ArmClient).compute().availSetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
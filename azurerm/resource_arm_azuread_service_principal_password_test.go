@@ -78,6 +78,40 @@ func TestAccAzureRMActiveDirectoryServicePrincipalPassword_customKeyId(t *testin
 	})
 }
 
+func TestAccAzureRMActiveDirectoryServicePrincipalPassword_requiresImport(t *testing.T) {
+	resourceName := "azurerm_azuread_service_principal_password.test"
+	applicationId, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyId, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMActiveDirectoryServicePrincipalPassword_customKeyId(applicationId, keyId, value),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryServicePrincipalPasswordExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMActiveDirectoryServicePrincipalPassword_requiresImport(applicationId, keyId, value),
+				ExpectError: testRequiresImportError("azurerm_azuread_service_principal_password"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMActiveDirectoryServicePrincipalPasswordExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -85,7 +119,7 @@ func testCheckAzureRMActiveDirectoryServicePrincipalPasswordExists(name string)
 			return fmt.Errorf("Not found: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).servicePrincipalsClient
+		client := testAccProvider.Meta().(*ArmClient).graph().servicePrincipalsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		id := strings.Split(rs.Primary.ID, "/")
@@ -155,3 +189,17 @@ resource "azurerm_azuread_service_principal_password" "test" {
 }
 `, applicationId, keyId, value)
 }
+
+func testAccAzureRMActiveDirectoryServicePrincipalPassword_requiresImport(applicationId, keyId, value string) string {
+	template := testAccAzureRMActiveDirectoryServicePrincipalPassword_customKeyId(applicationId, keyId, value)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_azuread_service_principal_password" "import" {
+  service_principal_id = "${azurerm_azuread_service_principal_password.test.service_principal_id}"
+  key_id               = "${azurerm_azuread_service_principal_password.test.key_id}"
+  value                = "${azurerm_azuread_service_principal_password.test.value}"
+  end_date             = "${azurerm_azuread_service_principal_password.test.end_date}"
+}
+`, template)
+}
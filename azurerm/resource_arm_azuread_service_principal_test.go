@@ -44,7 +44,7 @@ func testCheckAzureRMActiveDirectoryServicePrincipalExists(name string) resource
 			return fmt.Errorf("Not found: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).servicePrincipalsClient
+		client := testAccProvider.Meta().(*ArmClient).graph().servicePrincipalsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, rs.Primary.ID)
 
@@ -65,7 +65,7 @@ func testCheckAzureRMActiveDirectoryServicePrincipalDestroy(s *terraform.State)
 			continue
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).servicePrincipalsClient
+		client := testAccProvider.Meta().(*ArmClient).graph().servicePrincipalsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, rs.Primary.ID)
 
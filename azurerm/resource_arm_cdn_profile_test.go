@@ -24,7 +24,7 @@ func testSweepCDNProfiles(region string) error {
 		return err
 	}
 
-	client := (*armClient).cdnProfilesClient
+	client := (*armClient).cdn().cdnProfilesClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the CDN Profiles..")
@@ -248,7 +248,7 @@ func testCheckAzureRMCdnProfileExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for cdn profile: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+		conn := testAccProvider.Meta().(*ArmClient).cdn().cdnProfilesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -265,7 +265,7 @@ func testCheckAzureRMCdnProfileExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMCdnProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+	conn := testAccProvider.Meta().(*ArmClient).cdn().cdnProfilesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -98,7 +98,7 @@ func TestAccAzureRMCognitiveAccount_update(t *testing.T) {
 }
 
 func testCheckAzureRMAppCognitiveAccountDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).cognitiveAccountsClient
+	client := testAccProvider.Meta().(*ArmClient).cognitive().cognitiveAccountsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -134,7 +134,7 @@ func testCheckAzureRMCognitiveAccountExists(name string) resource.TestCheckFunc
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).cognitiveAccountsClient
+		conn := testAccProvider.Meta().(*ArmClient).cognitive().cognitiveAccountsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.GetProperties(ctx, resourceGroup, name)
@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func TestAccAzureRMContainerRegistryMigrateState(t *testing.T) {
@@ -19,7 +20,9 @@ func TestAccAzureRMContainerRegistryMigrateState(t *testing.T) {
 		return
 	}
 
-	client, err := getArmClient(config, false)
+	client, err := getArmClient(config, armClientOptions{
+		retryPolicy: azure.DefaultRetryPolicy(),
+	})
 	if err != nil {
 		t.Fatal(fmt.Errorf("Error building ARM Client: %+v", err))
 		return
@@ -102,7 +105,7 @@ func createResourceGroup(ctx context.Context, client *ArmClient, resourceGroupNa
 	group := resources.Group{
 		Location: &location,
 	}
-	_, err := client.resourceGroupsClient.CreateOrUpdate(ctx, resourceGroupName, group)
+	_, err := client.resources().resourceGroupsClient.CreateOrUpdate(ctx, resourceGroupName, group)
 	if err != nil {
 		return fmt.Errorf("Error creating Resource Group %q: %+v", resourceGroupName, err)
 	}
@@ -110,7 +113,7 @@ func createResourceGroup(ctx context.Context, client *ArmClient, resourceGroupNa
 }
 
 func createStorageAccount(client *ArmClient, resourceGroupName, storageAccountName, location string) (*storage.Account, error) {
-	storageClient := client.storageServiceClient
+	storageClient := client.storage().storageServiceClient
 	createParams := storage.AccountCreateParameters{
 		Location: &location,
 		Kind:     storage.Storage,
@@ -140,6 +143,6 @@ func createStorageAccount(client *ArmClient, resourceGroupName, storageAccountNa
 
 func destroyStorageAccountAndResourceGroup(client *ArmClient, resourceGroupName, storageAccountName string) {
 	ctx := client.StopContext
-	client.storageServiceClient.Delete(ctx, resourceGroupName, storageAccountName)
-	client.resourceGroupsClient.Delete(ctx, resourceGroupName)
+	client.storage().storageServiceClient.Delete(ctx, resourceGroupName, storageAccountName)
+	client.resources().resourceGroupsClient.Delete(ctx, resourceGroupName)
 }
@@ -107,7 +107,7 @@ func testCheckAzureRMDataLakeAnalyticsAccountExists(name string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for data lake store: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsAccountClient
+		conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeAnalyticsAccountClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, accountName)
@@ -124,7 +124,7 @@ func testCheckAzureRMDataLakeAnalyticsAccountExists(name string) resource.TestCh
 }
 
 func testCheckAzureRMDataLakeAnalyticsAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsAccountClient
+	conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeAnalyticsAccountClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -111,7 +111,7 @@ func testCheckAzureRMDataLakeAnalyticsFirewallRuleExists(name string) resource.T
 			return fmt.Errorf("Bad: no resource group found in state for data lake store firewall rule: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsFirewallRulesClient
+		conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeAnalyticsFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, accountName, firewallRuleName)
@@ -128,7 +128,7 @@ func testCheckAzureRMDataLakeAnalyticsFirewallRuleExists(name string) resource.T
 }
 
 func testCheckAzureRMDataLakeAnalyticsFirewallRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsFirewallRulesClient
+	conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeAnalyticsFirewallRulesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
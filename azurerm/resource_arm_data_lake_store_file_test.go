@@ -49,7 +49,7 @@ func testCheckAzureRMDataLakeStoreFileExists(name string) resource.TestCheckFunc
 		remoteFilePath := rs.Primary.Attributes["remote_file_path"]
 		accountName := rs.Primary.Attributes["account_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFilesClient
+		conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeStoreFilesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.GetFileStatus(ctx, accountName, remoteFilePath, utils.Bool(true))
@@ -66,7 +66,7 @@ func testCheckAzureRMDataLakeStoreFileExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMDataLakeStoreFileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFilesClient
+	conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeStoreFilesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
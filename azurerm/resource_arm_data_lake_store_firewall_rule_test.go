@@ -111,7 +111,7 @@ func testCheckAzureRMDataLakeStoreFirewallRuleExists(name string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for data lake store firewall rule: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFirewallRulesClient
+		conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeStoreFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, accountName, firewallRuleName)
@@ -128,7 +128,7 @@ func testCheckAzureRMDataLakeStoreFirewallRuleExists(name string) resource.TestC
 }
 
 func testCheckAzureRMDataLakeStoreFirewallRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFirewallRulesClient
+	conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeStoreFirewallRulesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -181,7 +181,7 @@ func testCheckAzureRMDataLakeStoreExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for data lake store: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreAccountClient
+		conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeStoreAccountClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, accountName)
@@ -198,7 +198,7 @@ func testCheckAzureRMDataLakeStoreExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMDataLakeStoreDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreAccountClient
+	conn := testAccProvider.Meta().(*ArmClient).dataLake().dataLakeStoreAccountClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
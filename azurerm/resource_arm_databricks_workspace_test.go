@@ -143,7 +143,7 @@ func testCheckAzureRMDatabricksWorkspaceExists(name string) resource.TestCheckFu
 			return fmt.Errorf("Bad: No resource group found in state for Databricks Workspace: %s", workspaceName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).databricksWorkspacesClient
+		conn := testAccProvider.Meta().(*ArmClient).databricks().databricksWorkspacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, workspaceName)
 		if err != nil {
@@ -159,7 +159,7 @@ func testCheckAzureRMDatabricksWorkspaceExists(name string) resource.TestCheckFu
 }
 
 func testCheckAzureRMDatabricksWorkspaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).databricksWorkspacesClient
+	conn := testAccProvider.Meta().(*ArmClient).databricks().databricksWorkspacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
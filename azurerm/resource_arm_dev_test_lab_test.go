@@ -79,7 +79,7 @@ func testCheckAzureRMDevTestLabExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for DevTest Lab: %s", labName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).devTestLabsClient
+		conn := testAccProvider.Meta().(*ArmClient).devTest().devTestLabsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, labName, "")
 		if err != nil {
@@ -95,7 +95,7 @@ func testCheckAzureRMDevTestLabExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMDevTestLabDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).devTestLabsClient
+	conn := testAccProvider.Meta().(*ArmClient).devTest().devTestLabsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
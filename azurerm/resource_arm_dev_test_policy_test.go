@@ -76,7 +76,7 @@ func testCheckAzureRMDevTestPolicyExists(name string) resource.TestCheckFunc {
 		labName := rs.Primary.Attributes["lab_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).devTestPoliciesClient
+		conn := testAccProvider.Meta().(*ArmClient).devTest().devTestPoliciesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, labName, policySetName, policyName, "")
@@ -93,7 +93,7 @@ func testCheckAzureRMDevTestPolicyExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMDevTestPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).devTestPoliciesClient
+	conn := testAccProvider.Meta().(*ArmClient).devTest().devTestPoliciesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
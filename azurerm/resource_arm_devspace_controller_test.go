@@ -50,7 +50,7 @@ func testCheckAzureRMDevSpaceControllerExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for DevSpace Controller: %s", ctrlName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).devSpaceControllerClient
+		client := testAccProvider.Meta().(*ArmClient).devSpace().devSpaceControllerClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		result, err := client.Get(ctx, resGroupName, ctrlName)
 
@@ -67,7 +67,7 @@ func testCheckAzureRMDevSpaceControllerExists(name string) resource.TestCheckFun
 }
 
 func testCheckAzureRMDevSpaceControllerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).devSpaceControllerClient
+	client := testAccProvider.Meta().(*ArmClient).devSpace().devSpaceControllerClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -73,7 +73,7 @@ func testCheckAzureRMExpressRouteCircuitAuthorizationExists(name string) resourc
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit Authorization: %s", expressRouteCircuitName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).expressRouteAuthsClient
+		client := testAccProvider.Meta().(*ArmClient).network().expressRouteAuthsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, expressRouteCircuitName, authorizationName)
@@ -90,7 +90,7 @@ func testCheckAzureRMExpressRouteCircuitAuthorizationExists(name string) resourc
 }
 
 func testCheckAzureRMExpressRouteCircuitAuthorizationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).expressRouteAuthsClient
+	client := testAccProvider.Meta().(*ArmClient).network().expressRouteAuthsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -79,7 +79,7 @@ func testCheckAzureRMExpressRouteCircuitPeeringExists(name string) resource.Test
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit Peering: %s", peeringType)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).expressRoutePeeringsClient
+		client := testAccProvider.Meta().(*ArmClient).network().expressRoutePeeringsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, circuitName, peeringType)
@@ -96,7 +96,7 @@ func testCheckAzureRMExpressRouteCircuitPeeringExists(name string) resource.Test
 }
 
 func testCheckAzureRMExpressRouteCircuitPeeringDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).expressRoutePeeringsClient
+	client := testAccProvider.Meta().(*ArmClient).network().expressRoutePeeringsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"strings"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +27,13 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vault_name": {
 				Type:     schema.TypeString,
@@ -63,8 +74,17 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 }
 
 func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta interface{}, action keyvault.AccessPolicyUpdateKind) error {
-	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).keyVault().keyVaultClient
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if action == keyvault.Remove {
+		ctx, cancel = timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	} else {
+		ctx, cancel = timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	}
+	defer cancel()
+
 	log.Printf("[INFO] Preparing arguments for Key Vault Access Policy: %s.", action)
 
 	vaultName := d.Get("vault_name").(string)
@@ -120,6 +140,28 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 	azureRMLockByName(vaultName, keyVaultResourceName)
 	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
 
+	if action == keyvault.Add && meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		keyVault, err := client.Get(ctx, resGroup, vaultName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+		}
+
+		if props := keyVault.Properties; props != nil && keyVault.ID != nil {
+			existing, err := findKeyVaultAccessPolicy(props.AccessPolicies, objectId, applicationIdRaw)
+			if err != nil {
+				return fmt.Errorf("Error locating Access Policy (Object ID %q / Application ID %q) in Key Vault %q (Resource Group %q)", objectId, applicationIdRaw, vaultName, resGroup)
+			}
+
+			if existing != nil {
+				resourceId := fmt.Sprintf("%s/objectId/%s", *keyVault.ID, objectId)
+				if applicationIdRaw != "" {
+					resourceId = fmt.Sprintf("%s/applicationId/%s", resourceId, applicationIdRaw)
+				}
+				return tf.ImportAsExistsError("azurerm_key_vault_access_policy", resourceId)
+			}
+		}
+	}
+
 	_, err = client.UpdateAccessPolicy(ctx, resGroup, vaultName, action, parameters)
 	if err != nil {
 		return fmt.Errorf("Error updating Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q): %+v", objectId, applicationIdRaw, vaultName, resGroup, err)
@@ -161,8 +203,9 @@ func resourceArmKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).keyVault().keyVaultClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 
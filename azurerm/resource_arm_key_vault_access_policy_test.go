@@ -128,7 +128,7 @@ func testCheckAzureRMKeyVaultAccessPolicyExists(name string) resource.TestCheckF
 		objectId := rs.Primary.Attributes["object_id"]
 		applicationId := rs.Primary.Attributes["application_id"]
 
-		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+		client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resGroup, vaultName)
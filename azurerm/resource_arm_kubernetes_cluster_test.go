@@ -429,7 +429,7 @@ func testCheckAzureRMKubernetesClusterExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Managed Kubernetes Cluster: %s", resourceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).kubernetesClustersClient
+		client := testAccProvider.Meta().(*ArmClient).containerServices().kubernetesClustersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		aks, err := client.Get(ctx, resourceGroup, resourceName)
@@ -446,7 +446,7 @@ func testCheckAzureRMKubernetesClusterExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMKubernetesClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).kubernetesClustersClient
+	conn := testAccProvider.Meta().(*ArmClient).containerServices().kubernetesClustersClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_kubernetes_cluster" {
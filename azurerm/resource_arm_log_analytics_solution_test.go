@@ -59,7 +59,7 @@ func TestAccAzureRMLogAnalyticsSolution_basicSecurity(t *testing.T) {
 }
 
 func testCheckAzureRMLogAnalyticsSolutionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).solutionsClient
+	conn := testAccProvider.Meta().(*ArmClient).operationalInsights().solutionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -98,7 +98,7 @@ func testCheckAzureRMLogAnalyticsSolutionExists(name string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for Log Analytics Workspace: %q", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).solutionsClient
+		conn := testAccProvider.Meta().(*ArmClient).operationalInsights().solutionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
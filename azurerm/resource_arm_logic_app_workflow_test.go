@@ -89,7 +89,7 @@ func testCheckAzureRMLogicAppWorkflowExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Logic App Workflow: %s", workflowName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).logicWorkflowsClient
+		client := testAccProvider.Meta().(*ArmClient).logic().logicWorkflowsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, workflowName)
@@ -106,7 +106,7 @@ func testCheckAzureRMLogicAppWorkflowExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMLogicAppWorkflowDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).logicWorkflowsClient
+	client := testAccProvider.Meta().(*ArmClient).logic().logicWorkflowsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
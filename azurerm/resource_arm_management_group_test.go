@@ -192,7 +192,7 @@ func testCheckAzureRMManagementGroupExists(name string) resource.TestCheckFunc {
 
 		name := rs.Primary.Attributes["group_id"]
 
-		client := testAccProvider.Meta().(*ArmClient).managementGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).managementGroups().managementGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		recurse := false
@@ -210,7 +210,7 @@ func testCheckAzureRMManagementGroupExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMManagementGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).managementGroupsClient
+	client := testAccProvider.Meta().(*ArmClient).managementGroups().managementGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
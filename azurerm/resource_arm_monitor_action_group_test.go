@@ -449,7 +449,7 @@ resource "azurerm_monitor_action_group" "test" {
 }
 
 func testCheckAzureRMMonitorActionGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).monitorActionGroupsClient
+	conn := testAccProvider.Meta().(*ArmClient).monitor().monitorActionGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -488,7 +488,7 @@ func testCheckAzureRMMonitorActionGroupExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for Action Group Instance: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).monitorActionGroupsClient
+		conn := testAccProvider.Meta().(*ArmClient).monitor().monitorActionGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, resourceName)
@@ -23,7 +23,7 @@ func testSweepMonitorLogProfiles(region string) error {
 		return fmt.Errorf("Error building config for sweepers: %+v", err)
 	}
 
-	client := (*armClient).monitorLogProfilesClient
+	client := (*armClient).monitor().monitorLogProfilesClient
 	ctx := (*armClient).StopContext
 
 	results, err := client.List(ctx)
@@ -170,7 +170,7 @@ func testAccAzureRMMonitorLogProfile_disappears(t *testing.T) {
 }
 
 func testCheckAzureRMLogProfileDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).monitorLogProfilesClient
+	client := testAccProvider.Meta().(*ArmClient).monitor().monitorLogProfilesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -198,7 +198,7 @@ func testCheckAzureRMLogProfileExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).monitorLogProfilesClient
+		client := testAccProvider.Meta().(*ArmClient).monitor().monitorLogProfilesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		name := rs.Primary.Attributes["name"]
@@ -225,7 +225,7 @@ func testCheckAzureRMLogProfileDisappears(name string) resource.TestCheckFunc {
 
 		name := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).monitorLogProfilesClient
+		client := testAccProvider.Meta().(*ArmClient).monitor().monitorLogProfilesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		_, err := client.Delete(ctx, name)
@@ -304,7 +304,7 @@ resource "azurerm_monitor_metric_alert" "test" {
 }
 
 func testCheckAzureRMMonitorMetricAlertDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).monitorMetricAlertsClient
+	conn := testAccProvider.Meta().(*ArmClient).monitor().monitorMetricAlertsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_monitor_metric_alert" {
@@ -339,7 +339,7 @@ func testCheckAzureRMMonitorMetricAlertExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for Metric Alert Instance: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).monitorMetricAlertsClient
+		conn := testAccProvider.Meta().(*ArmClient).monitor().monitorMetricAlertsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, resourceName)
@@ -191,7 +191,7 @@ func testCheckAzureRMMsSqlElasticPoolExists(name string) resource.TestCheckFunc
 		serverName := rs.Primary.Attributes["server_name"]
 		poolName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).msSqlElasticPoolsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().msSqlElasticPoolsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, poolName)
@@ -208,7 +208,7 @@ func testCheckAzureRMMsSqlElasticPoolExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMMsSqlElasticPoolDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).msSqlElasticPoolsClient
+	client := testAccProvider.Meta().(*ArmClient).databases().msSqlElasticPoolsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -245,7 +245,7 @@ func testCheckAzureRMMsSqlElasticPoolDisappears(name string) resource.TestCheckF
 		serverName := rs.Primary.Attributes["server_name"]
 		poolName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).msSqlElasticPoolsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().msSqlElasticPoolsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		if _, err := client.Delete(ctx, resourceGroup, serverName, poolName); err != nil {
@@ -50,7 +50,7 @@ func testCheckAzureRMMySQLFirewallRuleExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for MySQL Firewall Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlFirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -66,7 +66,7 @@ func testCheckAzureRMMySQLFirewallRuleExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMMySQLFirewallRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).mysqlDatabasesClient
+	client := testAccProvider.Meta().(*ArmClient).databases().mysqlDatabasesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
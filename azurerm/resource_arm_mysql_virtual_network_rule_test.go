@@ -122,7 +122,7 @@ func testCheckAzureRMMySqlVirtualNetworkRuleExists(name string) resource.TestChe
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlVirtualNetworkRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlVirtualNetworkRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, ruleName)
@@ -148,7 +148,7 @@ func testCheckAzureRMMySqlVirtualNetworkRuleDestroy(s *terraform.State) error {
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlVirtualNetworkRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlVirtualNetworkRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, ruleName)
@@ -178,7 +178,7 @@ func testCheckAzureRMMySqlVirtualNetworkRuleDisappears(name string) resource.Tes
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlVirtualNetworkRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlVirtualNetworkRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, serverName, ruleName)
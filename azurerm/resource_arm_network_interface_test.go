@@ -30,7 +30,7 @@ func testSweepNetworkInterfaces(region string) error {
 		return err
 	}
 
-	client := (*armClient).ifaceClient
+	client := (*armClient).network().ifaceClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Network Interfaces..")
@@ -439,7 +439,7 @@ func testCheckAzureRMNetworkInterfaceExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		client := testAccProvider.Meta().(*ArmClient).network().ifaceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name, "")
@@ -469,7 +469,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(name string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		client := testAccProvider.Meta().(*ArmClient).network().ifaceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, name)
@@ -487,7 +487,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(name string) resource.TestCheckF
 }
 
 func testCheckAzureRMNetworkInterfaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).ifaceClient
+	client := testAccProvider.Meta().(*ArmClient).network().ifaceClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -147,7 +147,7 @@ func testCheckAzureRMNotificationHubAuthorizationRuleExists(name string) resourc
 			return fmt.Errorf("not found: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).notificationHubsClient
+		client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationHubsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
@@ -169,7 +169,7 @@ func testCheckAzureRMNotificationHubAuthorizationRuleExists(name string) resourc
 }
 
 func testCheckAzureRMNotificationHubAuthorizationRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).notificationHubsClient
+	client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationHubsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -43,7 +43,7 @@ func testCheckAzureRMNotificationHubNamespaceExists(name string) resource.TestCh
 			return fmt.Errorf("not found: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).notificationNamespacesClient
+		client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationNamespacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
@@ -63,7 +63,7 @@ func testCheckAzureRMNotificationHubNamespaceExists(name string) resource.TestCh
 }
 
 func testCheckAzureRMNotificationHubNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).notificationNamespacesClient
+	client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationNamespacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -45,7 +45,7 @@ func testCheckAzureRMNotificationHubExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("not found: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).notificationHubsClient
+		client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationHubsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
@@ -66,7 +66,7 @@ func testCheckAzureRMNotificationHubExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMNotificationHubDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).notificationHubsClient
+	client := testAccProvider.Meta().(*ArmClient).notificationHubs().notificationHubsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
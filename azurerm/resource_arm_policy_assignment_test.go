@@ -69,7 +69,7 @@ func testCheckAzureRMPolicyAssignmentExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("not found: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).policyAssignmentsClient
+		client := testAccProvider.Meta().(*ArmClient).policy().policyAssignmentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		id := rs.Primary.ID
@@ -87,7 +87,7 @@ func testCheckAzureRMPolicyAssignmentExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMPolicyAssignmentDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).policyAssignmentsClient
+	client := testAccProvider.Meta().(*ArmClient).policy().policyAssignmentsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
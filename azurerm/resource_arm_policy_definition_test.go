@@ -44,7 +44,7 @@ func testCheckAzureRMPolicyDefinitionExists(name string) resource.TestCheckFunc
 
 		policyName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).policyDefinitionsClient
+		client := testAccProvider.Meta().(*ArmClient).policy().policyDefinitionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, policyName)
@@ -61,7 +61,7 @@ func testCheckAzureRMPolicyDefinitionExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMPolicyDefinitionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).policyDefinitionsClient
+	client := testAccProvider.Meta().(*ArmClient).policy().policyDefinitionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
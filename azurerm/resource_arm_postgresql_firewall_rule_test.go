@@ -52,7 +52,7 @@ func testCheckAzureRMPostgreSQLFirewallRuleExists(name string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for PostgreSQL Firewall Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).postgresqlFirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().postgresqlFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -69,7 +69,7 @@ func testCheckAzureRMPostgreSQLFirewallRuleExists(name string) resource.TestChec
 }
 
 func testCheckAzureRMPostgreSQLFirewallRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).postgresqlDatabasesClient
+	client := testAccProvider.Meta().(*ArmClient).databases().postgresqlDatabasesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -309,7 +309,7 @@ func testCheckAzureRMPostgreSQLServerExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for PostgreSQL Server: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).postgresqlServersClient
+		client := testAccProvider.Meta().(*ArmClient).databases().postgresqlServersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -326,7 +326,7 @@ func testCheckAzureRMPostgreSQLServerExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMPostgreSQLServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).postgresqlServersClient
+	client := testAccProvider.Meta().(*ArmClient).databases().postgresqlServersClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
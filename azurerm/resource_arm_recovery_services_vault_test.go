@@ -52,7 +52,7 @@ func testCheckAzureRMRecoveryServicesVaultDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).recoveryServicesVaultsClient
+		client := testAccProvider.Meta().(*ArmClient).recoveryServices().recoveryServicesVaultsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -84,7 +84,7 @@ func testCheckAzureRMRecoveryServicesVaultExists(name string) resource.TestCheck
 			return fmt.Errorf("Bad: no resource group found in state for Recovery Services Vault: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).recoveryServicesVaultsClient
+		client := testAccProvider.Meta().(*ArmClient).recoveryServices().recoveryServicesVaultsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -25,7 +25,7 @@ func testSweepResourceGroups(region string) error {
 		return err
 	}
 
-	client := (*armClient).resourceGroupsClient
+	client := (*armClient).resources().resourceGroupsClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Resource Groups..")
@@ -80,6 +80,30 @@ func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMResourceGroup_requiresImport(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMResourceGroup_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_resource_group"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMResourceGroup_disappears(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
 	ri := acctest.RandInt()
@@ -156,7 +180,7 @@ func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup)
@@ -183,7 +207,7 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		deleteFuture, err := client.Delete(ctx, resourceGroup)
@@ -201,7 +225,7 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+	client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -233,6 +257,18 @@ resource "azurerm_resource_group" "test" {
 `, rInt, location)
 }
 
+func testAccAzureRMResourceGroup_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMResourceGroup_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "import" {
+  name     = "${azurerm_resource_group.test.name}"
+  location = "${azurerm_resource_group.test.location}"
+}
+`, template)
+}
+
 func testAccAzureRMResourceGroup_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -71,7 +71,7 @@ func testCheckAzureRMSchedulerJobCollectionDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).schedulerJobCollectionsClient
+		client := testAccProvider.Meta().(*ArmClient).scheduler().schedulerJobCollectionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -104,7 +104,7 @@ func testCheckAzureRMSchedulerJobCollectionExists(name string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for Scheduler Job Collection: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).schedulerJobCollectionsClient
+		client := testAccProvider.Meta().(*ArmClient).scheduler().schedulerJobCollectionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
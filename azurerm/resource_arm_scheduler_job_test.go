@@ -484,7 +484,7 @@ func testCheckAzureRMSchedulerJobDestroy(s *terraform.State) error {
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		jobCollection := rs.Primary.Attributes["job_collection_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).schedulerJobsClient
+		client := testAccProvider.Meta().(*ArmClient).scheduler().schedulerJobsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, jobCollection, name)
@@ -518,7 +518,7 @@ func testCheckAzureRMSchedulerJobExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Scheduler Job: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).schedulerJobsClient
+		client := testAccProvider.Meta().(*ArmClient).scheduler().schedulerJobsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, jobCollection, name)
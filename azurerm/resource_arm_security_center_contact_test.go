@@ -75,7 +75,7 @@ func TestAccAzureRMSecurityCenterContact_update(t *testing.T) {
 
 func testCheckAzureRMSecurityCenterContactExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).securityCenterContactsClient
+		client := testAccProvider.Meta().(*ArmClient).securityCenter().securityCenterContactsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		rs, ok := s.RootModule().Resources[name]
@@ -99,7 +99,7 @@ func testCheckAzureRMSecurityCenterContactExists(name string) resource.TestCheck
 }
 
 func testCheckAzureRMSecurityCenterContactDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).securityCenterContactsClient
+	client := testAccProvider.Meta().(*ArmClient).securityCenter().securityCenterContactsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 	for _, res := range s.RootModule().Resources {
 		if res.Type != "azurerm_security_center_contact" {
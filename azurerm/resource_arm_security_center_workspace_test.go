@@ -81,7 +81,7 @@ func testAccAzureRMSecurityCenterWorkspace_update(t *testing.T) {
 
 func testCheckAzureRMSecurityCenterWorkspaceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).securityCenterWorkspaceClient
+		client := testAccProvider.Meta().(*ArmClient).securityCenter().securityCenterWorkspaceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		rs, ok := s.RootModule().Resources[name]
@@ -105,7 +105,7 @@ func testCheckAzureRMSecurityCenterWorkspaceExists(name string) resource.TestChe
 }
 
 func testCheckAzureRMSecurityCenterWorkspaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).securityCenterWorkspaceClient
+	client := testAccProvider.Meta().(*ArmClient).securityCenter().securityCenterWorkspaceClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, res := range s.RootModule().Resources {
@@ -448,7 +448,7 @@ func TestAccAzureRMServiceFabricCluster_tags(t *testing.T) {
 }
 
 func testCheckAzureRMServiceFabricClusterDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).serviceFabricClustersClient
+	client := testAccProvider.Meta().(*ArmClient).serviceFabric().serviceFabricClustersClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -487,7 +487,7 @@ func testCheckAzureRMServiceFabricClusterExists(name string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for Service Fabric Cluster %q", clusterName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).serviceFabricClustersClient
+		client := testAccProvider.Meta().(*ArmClient).serviceFabric().serviceFabricClustersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, clusterName)
@@ -25,7 +25,7 @@ func testSweepServiceBusNamespace(region string) error {
 		return err
 	}
 
-	client := (*armClient).serviceBusNamespacesClient
+	client := (*armClient).serviceBus().serviceBusNamespacesClient
 	ctx := armClient.StopContext
 
 	log.Printf("Retrieving the Servicebus Namespaces..")
@@ -172,7 +172,7 @@ func TestAccAzureRMServiceBusNamespace_premium(t *testing.T) {
 }
 
 func testCheckAzureRMServiceBusNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
+	client := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusNamespacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -211,7 +211,7 @@ func testCheckAzureRMServiceBusNamespaceExists(name string) resource.TestCheckFu
 			return fmt.Errorf("Bad: no resource group found in state for Service Bus Namespace: %s", namespaceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).serviceBusNamespacesClient
+		client := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusNamespacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, namespaceName)
@@ -327,7 +327,7 @@ func TestAccAzureRMServiceBusQueue_maxDeliveryCount(t *testing.T) {
 }
 
 func testCheckAzureRMServiceBusQueueDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).serviceBusQueuesClient
+	client := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusQueuesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -370,7 +370,7 @@ func testCheckAzureRMServiceBusQueueExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for queue: %s", queueName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).serviceBusQueuesClient
+		client := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusQueuesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, namespaceName, queueName)
@@ -76,7 +76,7 @@ func testCheckAzureRMSqlAdministratorExists(name string) resource.TestCheckFunc
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		serverName := rs.Primary.Attributes["server_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sqlServerAzureADAdministratorsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().sqlServerAzureADAdministratorsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		_, err := client.Get(ctx, resourceGroup, serverName)
@@ -94,7 +94,7 @@ func testCheckAzureRMSqlAdministratorDisappears(name string) resource.TestCheckF
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		serverName := rs.Primary.Attributes["server_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sqlServerAzureADAdministratorsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().sqlServerAzureADAdministratorsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		_, err := client.Delete(ctx, resourceGroup, serverName)
@@ -115,7 +115,7 @@ func testCheckAzureRMSqlAdministratorDestroy(s *terraform.State) error {
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		serverName := rs.Primary.Attributes["server_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sqlServerAzureADAdministratorsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().sqlServerAzureADAdministratorsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName)
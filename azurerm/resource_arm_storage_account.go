@@ -1,15 +1,22 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,12 +29,22 @@ func resourceArmStorageAccount() *schema.Resource {
 		Update: resourceArmStorageAccountUpdate,
 		Delete: resourceArmStorageAccountDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseStorageAccountID(id)
+			return err
+		}),
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
+		CustomizeDiff: resourceArmStorageAccountCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -180,6 +197,106 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": storageAccountCorsRuleSchema(),
+
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+
+						"versioning_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"queue_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": storageAccountCorsRuleSchema(),
+
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"delete": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"read": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"write": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"retention_policy_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+
+						"hour_metrics": storageAccountQueueMetricsSchema(),
+
+						"minute_metrics": storageAccountQueueMetricsSchema(),
+					},
+				},
+			},
+
+			"static_website": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"error_404_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
 			"primary_location": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -220,6 +337,26 @@ func resourceArmStorageAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"primary_web_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			// NOTE: The API does not appear to expose a secondary file endpoint
 			"primary_file_endpoint": {
 				Type:     schema.TypeString,
@@ -295,10 +432,35 @@ func resourceArmStorageAccount() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validateAzureRMStorageAccountTags,
 			},
+
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
+func resourceArmStorageAccountCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffForTags(d, meta); err != nil {
+		return err
+	}
+
+	accountKind := d.Get("account_kind").(string)
+	accountTier := d.Get("account_tier").(string)
+
+	if v := d.Get("static_website").([]interface{}); len(v) > 0 {
+		if !strings.EqualFold(accountKind, string(storage.StorageV2)) || !strings.EqualFold(accountTier, "Standard") {
+			return fmt.Errorf("`static_website` can only be specified for Standard `StorageV2` Storage Accounts")
+		}
+	}
+
+	if v := d.Get("queue_properties").([]interface{}); len(v) > 0 {
+		if strings.EqualFold(accountKind, string(storage.BlobStorage)) || !strings.EqualFold(accountTier, "Standard") {
+			return fmt.Errorf("`queue_properties` can only be specified for Standard `Storage` and `StorageV2` Storage Accounts")
+		}
+	}
+
+	return nil
+}
+
 func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []string, errors []error) {
 	tagsMap := v.(map[string]interface{})
 
@@ -323,7 +485,7 @@ func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []stri
 }
 
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).storageServiceClient
+	client := meta.(*ArmClient).storage().storageServiceClient
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	storageAccountName := d.Get("name").(string)
@@ -347,7 +509,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTagsWithDefaults(tags, meta),
 		Kind: storage.Kind(accountKind),
 		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
 			Encryption: &storage.Encryption{
@@ -393,7 +555,22 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroupName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_account", *existing.ID)
+		}
+	}
+
 	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
@@ -416,6 +593,25 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
+	if v, ok := d.GetOk("blob_properties"); ok {
+		blobProperties := expandStorageAccountBlobProperties(v.([]interface{}))
+		if err := meta.(*ArmClient).storage().storageAccountServicesClient.SetBlobServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account blob_properties %q: %+v", storageAccountName, err)
+		}
+	}
+
+	if v, ok := d.GetOk("queue_properties"); ok {
+		if err := updateStorageAccountQueueProperties(ctx, meta, resourceGroupName, storageAccountName, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("static_website"); ok {
+		if err := updateStorageAccountStaticWebsite(ctx, meta, resourceGroupName, storageAccountName, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -423,13 +619,14 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
-	id, err := parseAzureResourceID(d.Id())
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).storage().storageServiceClient
+	id, err := parse.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	storageAccountName := id.Path["storageAccounts"]
+	storageAccountName := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	accountTier := d.Get("account_tier").(string)
@@ -478,11 +675,11 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("access_tier")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
 		tags := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: expandTags(tags),
+			Tags: expandTagsWithDefaults(tags, meta),
 		}
 		_, err := client.Update(ctx, resourceGroupName, storageAccountName, opts)
 		if err != nil {
@@ -490,6 +687,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("enable_blob_encryption") || d.HasChange("enable_file_encryption") {
@@ -585,20 +783,46 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("network_rules")
 	}
 
+	if d.HasChange("blob_properties") {
+		blobProperties := expandStorageAccountBlobProperties(d.Get("blob_properties").([]interface{}))
+		if err := meta.(*ArmClient).storage().storageAccountServicesClient.SetBlobServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account blob_properties %q: %+v", storageAccountName, err)
+		}
+
+		d.SetPartial("blob_properties")
+	}
+
+	if d.HasChange("queue_properties") {
+		if err := updateStorageAccountQueueProperties(ctx, meta, resourceGroupName, storageAccountName, d.Get("queue_properties").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("queue_properties")
+	}
+
+	if d.HasChange("static_website") {
+		if err := updateStorageAccountStaticWebsite(ctx, meta, resourceGroupName, storageAccountName, d.Get("static_website").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("static_website")
+	}
+
 	d.Partial(false)
 	return resourceArmStorageAccountRead(d, meta)
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).storage().storageServiceClient
 	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parse.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	resp, err := client.GetProperties(ctx, resGroup, name)
@@ -708,6 +932,87 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	servicesClient := meta.(*ArmClient).storage().storageAccountServicesClient
+
+	// the Blob Service isn't available for `FileStorage` accounts
+	if !strings.EqualFold(string(resp.Kind), "FileStorage") {
+		blobProperties, err := servicesClient.GetBlobServiceProperties(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error reading the Blob Service Properties of AzureRM Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		// the defaults are returned when the Blob Service Properties have never been set, so these are only set
+		// when they've been changed or were previously managed - such that removing the block resets these
+		flattenedBlobProperties := make([]interface{}, 0)
+		if !storageAccountBlobPropertiesAreDefault(blobProperties.Properties) || len(d.Get("blob_properties").([]interface{})) > 0 {
+			flattenedBlobProperties = flattenStorageAccountBlobProperties(blobProperties.Properties)
+		}
+		if err := d.Set("blob_properties", flattenedBlobProperties); err != nil {
+			return fmt.Errorf("Error setting `blob_properties`: %+v", err)
+		}
+	}
+
+	d.Set("primary_web_endpoint", "")
+	d.Set("primary_web_host", "")
+	d.Set("secondary_web_endpoint", "")
+	d.Set("secondary_web_host", "")
+
+	// the Web Endpoints are only available for the kinds which support a Static Website
+	if strings.EqualFold(string(resp.Kind), string(storage.StorageV2)) || strings.EqualFold(string(resp.Kind), "BlockBlobStorage") {
+		webEndpoints, err := servicesClient.GetWebEndpoints(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error reading the Web Endpoints of AzureRM Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+		if props := webEndpoints.Properties; props != nil {
+			if endpoints := props.PrimaryEndpoints; endpoints != nil {
+				d.Set("primary_web_endpoint", endpoints.Web)
+				d.Set("primary_web_host", flattenStorageAccountHost(endpoints.Web))
+			}
+			if endpoints := props.SecondaryEndpoints; endpoints != nil {
+				d.Set("secondary_web_endpoint", endpoints.Web)
+				d.Set("secondary_web_host", flattenStorageAccountHost(endpoints.Web))
+			}
+		}
+	}
+
+	// the Queue Properties and Static Website are retrieved from the data plane (which may not be accessible, for
+	// example due to the Network Rules) - as such these are only retrieved when they're managed by Terraform
+	if len(d.Get("queue_properties").([]interface{})) > 0 {
+		queueClient, accountExists, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resGroup, name)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", name, resGroup)
+		}
+
+		queueProperties, err := queueClient.GetServiceProperties()
+		if err != nil {
+			return fmt.Errorf("Error reading the Queue Service Properties of AzureRM Storage Account %q: %+v", name, err)
+		}
+		if err := d.Set("queue_properties", flattenStorageAccountQueueProperties(queueProperties)); err != nil {
+			return fmt.Errorf("Error setting `queue_properties`: %+v", err)
+		}
+	}
+
+	if len(d.Get("static_website").([]interface{})) > 0 {
+		websiteClient, accountExists, err := meta.(*ArmClient).getStaticWebsiteClientForStorageAccount(ctx, resGroup, name)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", name, resGroup)
+		}
+
+		website, err := websiteClient.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("Error reading the Static Website of AzureRM Storage Account %q: %+v", name, err)
+		}
+		if err := d.Set("static_website", flattenStorageAccountStaticWebsite(website)); err != nil {
+			return fmt.Errorf("Error setting `static_website`: %+v", err)
+		}
+	}
+
 	d.Set("primary_access_key", accessKeys[0].Value)
 	d.Set("secondary_access_key", accessKeys[1].Value)
 
@@ -716,20 +1021,21 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsWithDefaults(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).storage().storageServiceClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parse.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	read, err := client.GetProperties(ctx, resourceGroup, name)
@@ -776,6 +1082,38 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func updateStorageAccountQueueProperties(ctx context.Context, meta interface{}, resourceGroupName, storageAccountName string, input []interface{}) error {
+	queueClient, accountExists, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+	}
+
+	if err := queueClient.SetServiceProperties(expandStorageAccountQueueProperties(input)); err != nil {
+		return fmt.Errorf("Error updating Azure Storage Account queue_properties %q: %+v", storageAccountName, err)
+	}
+
+	return nil
+}
+
+func updateStorageAccountStaticWebsite(ctx context.Context, meta interface{}, resourceGroupName, storageAccountName string, input []interface{}) error {
+	websiteClient, accountExists, err := meta.(*ArmClient).getStaticWebsiteClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+	}
+
+	if err := websiteClient.Set(ctx, expandStorageAccountStaticWebsite(input)); err != nil {
+		return fmt.Errorf("Error updating Azure Storage Account static_website %q: %+v", storageAccountName, err)
+	}
+
+	return nil
+}
+
 func expandStorageAccountCustomDomain(d *schema.ResourceData) *storage.CustomDomain {
 	domains := d.Get("custom_domain").([]interface{})
 	if len(domains) == 0 {
@@ -966,3 +1304,416 @@ func flattenAzureRmStorageAccountIdentity(identity *storage.Identity) []interfac
 
 	return []interface{}{result}
 }
+
+func storageAccountCorsRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_origins": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+				"allowed_methods": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"DELETE",
+							"GET",
+							"HEAD",
+							"MERGE",
+							"OPTIONS",
+							"POST",
+							"PUT",
+						}, false),
+					},
+				},
+				"allowed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"exposed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"max_age_in_seconds": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 2000000000),
+				},
+			},
+		},
+	}
+}
+
+func storageAccountQueueMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"include_apis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"retention_policy_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func expandStorageAccountBlobProperties(input []interface{}) storageBlobServiceProperties {
+	properties := storageBlobServicePropertiesProperties{
+		Cors: &storageCorsRules{
+			CorsRules: &[]storageCorsRule{},
+		},
+		DeleteRetentionPolicy: &storageDeleteRetentionPolicy{
+			Enabled: utils.Bool(false),
+		},
+		IsVersioningEnabled: utils.Bool(false),
+	}
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+
+		properties.Cors = &storageCorsRules{
+			CorsRules: expandStorageAccountBlobCorsRules(v["cors_rule"].([]interface{})),
+		}
+
+		if policies := v["delete_retention_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+			policy := policies[0].(map[string]interface{})
+			properties.DeleteRetentionPolicy = &storageDeleteRetentionPolicy{
+				Enabled: utils.Bool(true),
+				Days:    utils.Int32(int32(policy["days"].(int))),
+			}
+		}
+
+		properties.IsVersioningEnabled = utils.Bool(v["versioning_enabled"].(bool))
+	}
+
+	return storageBlobServiceProperties{
+		Properties: &properties,
+	}
+}
+
+func expandStorageAccountBlobCorsRules(input []interface{}) *[]storageCorsRule {
+	rules := make([]storageCorsRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rules = append(rules, storageCorsRule{
+			AllowedOrigins:  utils.ExpandStringArray(v["allowed_origins"].([]interface{})),
+			AllowedMethods:  utils.ExpandStringArray(v["allowed_methods"].([]interface{})),
+			AllowedHeaders:  utils.ExpandStringArray(v["allowed_headers"].([]interface{})),
+			ExposedHeaders:  utils.ExpandStringArray(v["exposed_headers"].([]interface{})),
+			MaxAgeInSeconds: utils.Int32(int32(v["max_age_in_seconds"].(int))),
+		})
+	}
+
+	return &rules
+}
+
+// storageAccountBlobPropertiesAreDefault returns whether none of the Blob Service Properties managed by Terraform
+// have been set - which is the case when the Blob Service Properties have never been configured
+func storageAccountBlobPropertiesAreDefault(input *storageBlobServicePropertiesProperties) bool {
+	if input == nil {
+		return true
+	}
+
+	if cors := input.Cors; cors != nil && cors.CorsRules != nil && len(*cors.CorsRules) > 0 {
+		return false
+	}
+
+	if policy := input.DeleteRetentionPolicy; policy != nil && policy.Enabled != nil && *policy.Enabled {
+		return false
+	}
+
+	return input.IsVersioningEnabled == nil || !*input.IsVersioningEnabled
+}
+
+func flattenStorageAccountBlobProperties(input *storageBlobServicePropertiesProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	corsRules := make([]interface{}, 0)
+	if cors := input.Cors; cors != nil && cors.CorsRules != nil {
+		for _, rule := range *cors.CorsRules {
+			maxAgeInSeconds := 0
+			if rule.MaxAgeInSeconds != nil {
+				maxAgeInSeconds = int(*rule.MaxAgeInSeconds)
+			}
+
+			corsRules = append(corsRules, map[string]interface{}{
+				"allowed_origins":    utils.FlattenStringArray(rule.AllowedOrigins),
+				"allowed_methods":    utils.FlattenStringArray(rule.AllowedMethods),
+				"allowed_headers":    utils.FlattenStringArray(rule.AllowedHeaders),
+				"exposed_headers":    utils.FlattenStringArray(rule.ExposedHeaders),
+				"max_age_in_seconds": maxAgeInSeconds,
+			})
+		}
+	}
+
+	deleteRetentionPolicies := make([]interface{}, 0)
+	if policy := input.DeleteRetentionPolicy; policy != nil && policy.Enabled != nil && *policy.Enabled {
+		days := 0
+		if policy.Days != nil {
+			days = int(*policy.Days)
+		}
+
+		deleteRetentionPolicies = append(deleteRetentionPolicies, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	versioningEnabled := false
+	if input.IsVersioningEnabled != nil {
+		versioningEnabled = *input.IsVersioningEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":               corsRules,
+			"delete_retention_policy": deleteRetentionPolicies,
+			"versioning_enabled":      versioningEnabled,
+		},
+	}
+}
+
+// expandStorageAccountQueueProperties expands the Queue Service Properties - where the CORS Rules, Logging and Metrics
+// are reset (disabled) when these aren't specified, since the Storage API leaves any omitted properties unchanged
+func expandStorageAccountQueueProperties(input []interface{}) mainStorage.ServiceProperties {
+	properties := mainStorage.ServiceProperties{
+		Cors: &mainStorage.Cors{
+			CorsRule: []mainStorage.CorsRule{},
+		},
+		Logging: &mainStorage.Logging{
+			Version: "1.0",
+			RetentionPolicy: &mainStorage.RetentionPolicy{
+				Enabled: false,
+			},
+		},
+		HourMetrics:   expandStorageAccountQueueMetrics([]interface{}{}),
+		MinuteMetrics: expandStorageAccountQueueMetrics([]interface{}{}),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return properties
+	}
+
+	v := input[0].(map[string]interface{})
+
+	for _, raw := range v["cors_rule"].([]interface{}) {
+		rule := raw.(map[string]interface{})
+		properties.Cors.CorsRule = append(properties.Cors.CorsRule, mainStorage.CorsRule{
+			AllowedOrigins:  strings.Join(*utils.ExpandStringArray(rule["allowed_origins"].([]interface{})), ","),
+			AllowedMethods:  strings.Join(*utils.ExpandStringArray(rule["allowed_methods"].([]interface{})), ","),
+			AllowedHeaders:  strings.Join(*utils.ExpandStringArray(rule["allowed_headers"].([]interface{})), ","),
+			ExposedHeaders:  strings.Join(*utils.ExpandStringArray(rule["exposed_headers"].([]interface{})), ","),
+			MaxAgeInSeconds: rule["max_age_in_seconds"].(int),
+		})
+	}
+
+	if logging := v["logging"].([]interface{}); len(logging) > 0 && logging[0] != nil {
+		l := logging[0].(map[string]interface{})
+		properties.Logging = &mainStorage.Logging{
+			Version:         l["version"].(string),
+			Delete:          l["delete"].(bool),
+			Read:            l["read"].(bool),
+			Write:           l["write"].(bool),
+			RetentionPolicy: expandStorageAccountQueueRetentionPolicy(l["retention_policy_days"].(int)),
+		}
+	}
+
+	properties.HourMetrics = expandStorageAccountQueueMetrics(v["hour_metrics"].([]interface{}))
+	properties.MinuteMetrics = expandStorageAccountQueueMetrics(v["minute_metrics"].([]interface{}))
+
+	return properties
+}
+
+func expandStorageAccountQueueMetrics(input []interface{}) *mainStorage.Metrics {
+	if len(input) == 0 || input[0] == nil {
+		return &mainStorage.Metrics{
+			Version: "1.0",
+			Enabled: false,
+			RetentionPolicy: &mainStorage.RetentionPolicy{
+				Enabled: false,
+			},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	metrics := mainStorage.Metrics{
+		Version:         v["version"].(string),
+		Enabled:         v["enabled"].(bool),
+		RetentionPolicy: expandStorageAccountQueueRetentionPolicy(v["retention_policy_days"].(int)),
+	}
+
+	// the API's can only be included when the Metrics are enabled
+	if metrics.Enabled {
+		metrics.IncludeAPIs = utils.Bool(v["include_apis"].(bool))
+	}
+
+	return &metrics
+}
+
+func expandStorageAccountQueueRetentionPolicy(days int) *mainStorage.RetentionPolicy {
+	if days == 0 {
+		return &mainStorage.RetentionPolicy{
+			Enabled: false,
+		}
+	}
+
+	return &mainStorage.RetentionPolicy{
+		Enabled: true,
+		Days:    &days,
+	}
+}
+
+func flattenStorageAccountQueueProperties(input *mainStorage.ServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	corsRules := make([]interface{}, 0)
+	if cors := input.Cors; cors != nil {
+		for _, rule := range cors.CorsRule {
+			corsRules = append(corsRules, map[string]interface{}{
+				"allowed_origins":    flattenStorageAccountQueueCorsRuleValues(rule.AllowedOrigins),
+				"allowed_methods":    flattenStorageAccountQueueCorsRuleValues(rule.AllowedMethods),
+				"allowed_headers":    flattenStorageAccountQueueCorsRuleValues(rule.AllowedHeaders),
+				"exposed_headers":    flattenStorageAccountQueueCorsRuleValues(rule.ExposedHeaders),
+				"max_age_in_seconds": rule.MaxAgeInSeconds,
+			})
+		}
+	}
+
+	logging := make([]interface{}, 0)
+	if l := input.Logging; l != nil && (l.Delete || l.Read || l.Write) {
+		logging = append(logging, map[string]interface{}{
+			"version":               l.Version,
+			"delete":                l.Delete,
+			"read":                  l.Read,
+			"write":                 l.Write,
+			"retention_policy_days": flattenStorageAccountQueueRetentionPolicy(l.RetentionPolicy),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":      corsRules,
+			"logging":        logging,
+			"hour_metrics":   flattenStorageAccountQueueMetrics(input.HourMetrics),
+			"minute_metrics": flattenStorageAccountQueueMetrics(input.MinuteMetrics),
+		},
+	}
+}
+
+func flattenStorageAccountQueueCorsRuleValues(input string) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func flattenStorageAccountQueueMetrics(input *mainStorage.Metrics) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	includeAPIs := false
+	if input.IncludeAPIs != nil {
+		includeAPIs = *input.IncludeAPIs
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"enabled":               input.Enabled,
+			"include_apis":          includeAPIs,
+			"retention_policy_days": flattenStorageAccountQueueRetentionPolicy(input.RetentionPolicy),
+		},
+	}
+}
+
+func flattenStorageAccountQueueRetentionPolicy(input *mainStorage.RetentionPolicy) int {
+	if input == nil || !input.Enabled || input.Days == nil {
+		return 0
+	}
+
+	return *input.Days
+}
+
+func expandStorageAccountStaticWebsite(input []interface{}) storageStaticWebsite {
+	if len(input) == 0 || input[0] == nil {
+		return storageStaticWebsite{
+			Enabled: false,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return storageStaticWebsite{
+		Enabled:              true,
+		IndexDocument:        v["index_document"].(string),
+		ErrorDocument404Path: v["error_404_document"].(string),
+	}
+}
+
+func flattenStorageAccountStaticWebsite(input storageStaticWebsite) []interface{} {
+	if !input.Enabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"index_document":     input.IndexDocument,
+			"error_404_document": input.ErrorDocument404Path,
+		},
+	}
+}
+
+// flattenStorageAccountHost returns the Host Name from the Endpoint, e.g. `example.z6.web.core.windows.net`
+func flattenStorageAccountHost(endpoint *string) string {
+	if endpoint == nil {
+		return ""
+	}
+
+	host := strings.TrimPrefix(*endpoint, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	return strings.TrimSuffix(host, "/")
+}
@@ -3,12 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestValidateArmStorageAccountType(t *testing.T) {
@@ -51,6 +53,164 @@ func TestValidateArmStorageAccountName(t *testing.T) {
 	}
 }
 
+func TestStorageAccountBlobPropertiesRoundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"cors_rule": []interface{}{
+				map[string]interface{}{
+					"allowed_origins":    []interface{}{"https://www.example.com"},
+					"allowed_methods":    []interface{}{"GET", "PUT"},
+					"allowed_headers":    []interface{}{"x-tempo-*"},
+					"exposed_headers":    []interface{}{"x-tempo-*"},
+					"max_age_in_seconds": 500,
+				},
+			},
+			"delete_retention_policy": []interface{}{
+				map[string]interface{}{
+					"days": 30,
+				},
+			},
+			"versioning_enabled": true,
+		},
+	}
+
+	expanded := expandStorageAccountBlobProperties(input)
+	if actual := flattenStorageAccountBlobProperties(expanded.Properties); !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestStorageAccountBlobPropertiesDefaults(t *testing.T) {
+	expanded := expandStorageAccountBlobProperties([]interface{}{})
+	props := expanded.Properties
+
+	if props.Cors == nil || props.Cors.CorsRules == nil || len(*props.Cors.CorsRules) != 0 {
+		t.Fatalf("Expected the CORS Rules to be removed")
+	}
+
+	if props.DeleteRetentionPolicy == nil || *props.DeleteRetentionPolicy.Enabled {
+		t.Fatalf("Expected the Delete Retention Policy to be disabled")
+	}
+
+	if props.IsVersioningEnabled == nil || *props.IsVersioningEnabled {
+		t.Fatalf("Expected Versioning to be disabled")
+	}
+
+	if !storageAccountBlobPropertiesAreDefault(props) {
+		t.Fatalf("Expected the reset Blob Service Properties to be the defaults")
+	}
+
+	versioned := expandStorageAccountBlobProperties([]interface{}{
+		map[string]interface{}{
+			"cors_rule":               []interface{}{},
+			"delete_retention_policy": []interface{}{},
+			"versioning_enabled":      true,
+		},
+	})
+	if storageAccountBlobPropertiesAreDefault(versioned.Properties) {
+		t.Fatalf("Expected Blob Service Properties with Versioning enabled not to be the defaults")
+	}
+}
+
+func TestStorageAccountQueuePropertiesRoundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"cors_rule": []interface{}{
+				map[string]interface{}{
+					"allowed_origins":    []interface{}{"https://www.example.com", "https://www.example.org"},
+					"allowed_methods":    []interface{}{"GET"},
+					"allowed_headers":    []interface{}{"x-tempo-*"},
+					"exposed_headers":    []interface{}{"x-tempo-*"},
+					"max_age_in_seconds": 500,
+				},
+			},
+			"logging": []interface{}{
+				map[string]interface{}{
+					"version":               "1.0",
+					"delete":                true,
+					"read":                  true,
+					"write":                 false,
+					"retention_policy_days": 7,
+				},
+			},
+			"hour_metrics": []interface{}{
+				map[string]interface{}{
+					"version":               "1.0",
+					"enabled":               true,
+					"include_apis":          true,
+					"retention_policy_days": 10,
+				},
+			},
+			"minute_metrics": []interface{}{},
+		},
+	}
+
+	expanded := expandStorageAccountQueueProperties(input)
+	if expanded.MinuteMetrics == nil || expanded.MinuteMetrics.Enabled || expanded.MinuteMetrics.IncludeAPIs != nil {
+		t.Fatalf("Expected the Minute Metrics to be disabled")
+	}
+
+	if actual := flattenStorageAccountQueueProperties(&expanded); !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestStorageAccountStaticWebsite(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input []interface{}
+	}{
+		{
+			Name:  "Disabled",
+			Input: []interface{}{},
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"index_document":     "index.html",
+					"error_404_document": "404.html",
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenStorageAccountStaticWebsite(expandStorageAccountStaticWebsite(v.Input))
+		if !reflect.DeepEqual(actual, v.Input) {
+			t.Fatalf("Expected %+v but got %+v", v.Input, actual)
+		}
+	}
+}
+
+func TestFlattenStorageAccountHost(t *testing.T) {
+	cases := []struct {
+		Input    *string
+		Expected string
+	}{
+		{
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Input:    utils.String("https://example.z6.web.core.windows.net/"),
+			Expected: "example.z6.web.core.windows.net",
+		},
+		{
+			Input:    utils.String("https://example-secondary.z6.web.core.windows.net"),
+			Expected: "example-secondary.z6.web.core.windows.net",
+		},
+	}
+
+	for _, v := range cases {
+		if actual := flattenStorageAccountHost(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
 func TestAccAzureRMStorageAccount_basic(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := acctest.RandInt()
@@ -93,6 +253,31 @@ func TestAccAzureRMStorageAccount_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageAccount_requiresImport(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccount_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageAccount_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_account"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageAccount_premium(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 	ri := acctest.RandInt()
@@ -519,6 +704,124 @@ func TestAccAzureRMStorageAccount_networkRulesDeleted(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageAccount_blobProperties(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccAzureRMStorageAccount_blobProperties(ri, rs, location)
+	postConfig := testAccAzureRMStorageAccount_blobPropertiesUpdated(ri, rs, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.delete_retention_policy.0.days", "300"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.versioning_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.delete_retention_policy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.versioning_enabled", "false"),
+				),
+			},
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageAccount_blobPropertiesRemoved(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccount_queueProperties(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	config := testAccAzureRMStorageAccount_queueProperties(ri, rs, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.0.retention_policy_days", "10"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.hour_metrics.0.include_apis", "true"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.minute_metrics.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"queue_properties"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccount_staticWebsite(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	config := testAccAzureRMStorageAccount_staticWebsite(ri, rs, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "static_website.0.index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "static_website.0.error_404_document", "404.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_web_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_web_host"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"static_website"},
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageAccountExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -532,7 +835,7 @@ func testCheckAzureRMStorageAccountExists(name string) resource.TestCheckFunc {
 
 		// Ensure resource group exists in API
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
-		conn := testAccProvider.Meta().(*ArmClient).storageServiceClient
+		conn := testAccProvider.Meta().(*ArmClient).storage().storageServiceClient
 
 		resp, err := conn.GetProperties(ctx, resourceGroup, storageAccount)
 		if err != nil {
@@ -560,7 +863,7 @@ func testCheckAzureRMStorageAccountDisappears(name string) resource.TestCheckFun
 
 		// Ensure resource group exists in API
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
-		conn := testAccProvider.Meta().(*ArmClient).storageServiceClient
+		conn := testAccProvider.Meta().(*ArmClient).storage().storageServiceClient
 
 		_, err := conn.Delete(ctx, resourceGroup, storageAccount)
 		if err != nil {
@@ -573,7 +876,7 @@ func testCheckAzureRMStorageAccountDisappears(name string) resource.TestCheckFun
 
 func testCheckAzureRMStorageAccountDestroy(s *terraform.State) error {
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
-	conn := testAccProvider.Meta().(*ArmClient).storageServiceClient
+	conn := testAccProvider.Meta().(*ArmClient).storage().storageServiceClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_storage_account" {
@@ -618,6 +921,21 @@ resource "azurerm_storage_account" "testsa" {
 `, rInt, location, rString)
 }
 
+func testAccAzureRMStorageAccount_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageAccount_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "import" {
+  name                     = "${azurerm_storage_account.testsa.name}"
+  resource_group_name      = "${azurerm_storage_account.testsa.resource_group_name}"
+  location                 = "${azurerm_storage_account.testsa.location}"
+  account_tier             = "${azurerm_storage_account.testsa.account_tier}"
+  account_replication_type = "${azurerm_storage_account.testsa.account_replication_type}"
+}
+`, template)
+}
+
 func testAccAzureRMStorageAccount_premium(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "testrg" {
@@ -1022,3 +1340,148 @@ resource "azurerm_storage_account" "testsa" {
 }
 `, rInt, location, rInt, rInt, rString)
 }
+
+func testAccAzureRMStorageAccount_blobProperties(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = "${azurerm_resource_group.testrg.name}"
+
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      exposed_headers    = ["x-tempo-*"]
+      allowed_headers    = ["x-tempo-*"]
+      allowed_methods    = ["GET", "PUT"]
+      max_age_in_seconds = "500"
+    }
+
+    delete_retention_policy {
+      days = 300
+    }
+
+    versioning_enabled = true
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_blobPropertiesUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = "${azurerm_resource_group.testrg.name}"
+
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = false
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_blobPropertiesRemoved(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = "${azurerm_resource_group.testrg.name}"
+
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_queueProperties(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = "${azurerm_resource_group.testrg.name}"
+
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  queue_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      exposed_headers    = ["x-tempo-*"]
+      allowed_headers    = ["x-tempo-*"]
+      allowed_methods    = ["GET", "PUT"]
+      max_age_in_seconds = "500"
+    }
+
+    logging {
+      version               = "1.0"
+      delete                = true
+      read                  = true
+      write                 = true
+      retention_policy_days = 10
+    }
+
+    hour_metrics {
+      version               = "1.0"
+      enabled               = true
+      include_apis          = true
+      retention_policy_days = 10
+    }
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_staticWebsite(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = "${azurerm_resource_group.testrg.name}"
+
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  static_website {
+    index_document     = "index.html"
+    error_404_document = "404.html"
+  }
+}
+`, rInt, location, rString)
+}
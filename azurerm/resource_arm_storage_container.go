@@ -9,9 +9,12 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageContainer() *schema.Resource {
@@ -26,6 +29,13 @@ func resourceArmStorageContainer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -46,6 +56,8 @@ func resourceArmStorageContainer() *schema.Resource {
 				ValidateFunc: validateArmStorageContainerAccessType,
 			},
 
+			"acl": storageAccessControlListSchema("racwdl"),
+
 			"properties": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -54,7 +66,7 @@ func resourceArmStorageContainer() *schema.Resource {
 	}
 }
 
-//Following the naming convention as laid out in the docs
+// Following the naming convention as laid out in the docs
 func validateArmStorageContainerName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^\$root$|^[0-9a-z-]+$`).MatchString(value) {
@@ -89,7 +101,8 @@ func validateArmStorageContainerAccessType(v interface{}, _ string) (warnings []
 
 func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -103,31 +116,50 @@ func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
 	}
 
-	var accessType storage.ContainerAccessType
-	if d.Get("container_access_type").(string) == "private" {
-		accessType = storage.ContainerAccessType("")
-	} else {
-		accessType = storage.ContainerAccessType(d.Get("container_access_type").(string))
-	}
-
 	log.Printf("[INFO] Creating container %q in storage account %q.", name, storageAccountName)
 	reference := blobClient.GetContainerReference(name)
 
-	err = resource.Retry(120*time.Second, checkContainerIsCreated(reference))
+	id := fmt.Sprintf("https://%s.blob.%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, name)
+	if armClient.requireResourcesToBeImported && d.IsNewResource() {
+		exists, err := reference.Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Container %q (Storage Account %q): %s", name, storageAccountName, err)
+		}
+
+		if exists {
+			return tf.ImportAsExistsError("azurerm_storage_container", id)
+		}
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	err = resource.Retry(timeout, checkContainerIsCreated(reference))
 	if err != nil {
 		return fmt.Errorf("Error creating container %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
-	permissions := storage.ContainerPermissions{
-		AccessType: accessType,
-	}
-	permissionOptions := &storage.SetContainerPermissionOptions{}
-	err = reference.SetPermissions(permissions, permissionOptions)
+	aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "blob", "container")
 	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	// the Access Type is set alongside the ACL, where "private" is represented by omitting the header
+	decorators := make([]autorest.PrepareDecorator, 0)
+	if accessType := d.Get("container_access_type").(string); accessType != "private" {
+		decorators = append(decorators, autorest.WithHeader("x-ms-blob-public-access", accessType))
+	}
+
+	log.Printf("[INFO] Setting permissions for container %q in storage account %q", name, storageAccountName)
+	acl := expandStorageAccessControlList(d.Get("acl").(*schema.Set).List())
+	if err := aclClient.Set(ctx, name, acl, decorators...); err != nil {
 		return fmt.Errorf("Error setting permissions for container %s in storage account %s: %+v", name, storageAccountName, err)
 	}
 
-	id := fmt.Sprintf("https://%s.blob.%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, name)
 	d.SetId(id)
 	return resourceArmStorageContainerRead(d, meta)
 }
@@ -136,14 +168,15 @@ func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interf
 // read the status of the storage container off Azure.
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -196,6 +229,24 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("container_access_type", string(container.Properties.PublicAccess))
 	}
 
+	aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName, "blob", "container")
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing container %q from state", id.storageAccountName, id.containerName)
+		d.SetId("")
+		return nil
+	}
+
+	acl, err := aclClient.Get(ctx, id.containerName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL for container %q in storage account %q: %+v", id.containerName, id.storageAccountName, err)
+	}
+	if err := d.Set("acl", flattenStorageAccessControlList(acl)); err != nil {
+		return fmt.Errorf("Error setting `acl`: %+v", err)
+	}
+
 	output := make(map[string]interface{})
 
 	output["last_modified"] = container.Properties.LastModified
@@ -214,14 +265,15 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 // delete a storage container off Azure.
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
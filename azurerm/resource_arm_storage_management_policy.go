@@ -0,0 +1,522 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the number of days for an action is optional, however 0 is a valid value - as such -1 is used when it's not set
+const storageManagementPolicyDaysNotSet = -1
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageManagementPolicyCreateUpdate,
+		Read:   resourceArmStorageManagementPolicyRead,
+		Update: resourceArmStorageManagementPolicyCreateUpdate,
+		Delete: resourceArmStorageManagementPolicyDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseStorageManagementPolicyID(id)
+			return err
+		}),
+
+		CustomizeDiff: resourceArmStorageManagementPolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     parse.ValidateStorageAccountID,
+				DiffSuppressFunc: azure.SuppressResourceIDDiff,
+				StateFunc:        azure.NormalizeResourceID,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[a-zA-Z0-9]{1,256}$`),
+								"The rule name must be between 1 and 256 characters in length and can only contain letters and numbers.",
+							),
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"filters": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										Set: schema.HashString,
+									},
+
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"blockBlob"}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      storageManagementPolicyDaysNotSet,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+
+												"tier_to_archive_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      storageManagementPolicyDaysNotSet,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+
+												"delete_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      storageManagementPolicyDaysNotSet,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+											},
+										},
+									},
+
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage().storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := parse.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := storageAccountId.ResourceGroup
+	storageAccountName := storageAccountId.Name
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_management_policy", *existing.ID)
+		}
+	}
+
+	parameters := storageManagementPolicy{
+		Properties: &storageManagementPolicyProperties{
+			Policy: &storageManagementPolicySchema{
+				Rules: expandStorageManagementPolicyRules(d.Get("rule").([]interface{})),
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, storageAccountName, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Management Policy for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage().storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
+
+	resp, err := client.Get(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Policy for Storage Account %q (Resource Group %q) was not found - removing from state", storageAccountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	storageAccountId := parse.NewStorageAccountID(id.SubscriptionID, resourceGroup, storageAccountName)
+	d.Set("storage_account_id", storageAccountId.ID())
+
+	var rules *[]storageManagementPolicyRule
+	if props := resp.Properties; props != nil && props.Policy != nil {
+		rules = props.Policy.Rules
+	}
+
+	if err := d.Set("rule", flattenStorageManagementPolicyRules(rules)); err != nil {
+		return fmt.Errorf("Error setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage().storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.StorageAccountName
+
+	if resp, err := client.Delete(ctx, resourceGroup, storageAccountName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	// the fields compared across the rules - a rule is only validated once all of these are known, since values
+	// interpolated from other resources aren't available until they're applied
+	fields := []string{
+		"name",
+		"actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than",
+		"actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than",
+		"actions.0.base_blob.0.delete_after_days_since_modification_greater_than",
+		"actions.0.snapshot.0.delete_after_days_since_creation_greater_than",
+	}
+
+	rules := make([]interface{}, 0)
+	for i, rule := range d.Get("rule").([]interface{}) {
+		known := true
+		for _, field := range fields {
+			if !d.NewValueKnown(fmt.Sprintf("rule.%d.%s", i, field)) {
+				known = false
+				break
+			}
+		}
+
+		if known {
+			rules = append(rules, rule)
+		}
+	}
+
+	return validateStorageManagementPolicyRules(rules)
+}
+
+// validateStorageManagementPolicyRules validates the combination of Rules and Actions, which Azure would otherwise
+// only reject when the Management Policy is applied
+func validateStorageManagementPolicyRules(input []interface{}) error {
+	names := make(map[string]bool)
+
+	for _, r := range input {
+		if r == nil {
+			continue
+		}
+
+		rule := r.(map[string]interface{})
+		name := rule["name"].(string)
+
+		if names[name] {
+			return fmt.Errorf("The name of each `rule` must be unique but %q is used more than once", name)
+		}
+		names[name] = true
+
+		actionsRaw := rule["actions"].([]interface{})
+		if len(actionsRaw) == 0 || actionsRaw[0] == nil {
+			return fmt.Errorf("At least one of `base_blob` or `snapshot` must be specified in the `actions` for the rule %q", name)
+		}
+		actions := actionsRaw[0].(map[string]interface{})
+
+		hasAction := false
+
+		if baseBlobRaw := actions["base_blob"].([]interface{}); len(baseBlobRaw) > 0 && baseBlobRaw[0] != nil {
+			baseBlob := baseBlobRaw[0].(map[string]interface{})
+			tierToCool := baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int)
+			tierToArchive := baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int)
+			deleteDays := baseBlob["delete_after_days_since_modification_greater_than"].(int)
+
+			if tierToCool == storageManagementPolicyDaysNotSet && tierToArchive == storageManagementPolicyDaysNotSet && deleteDays == storageManagementPolicyDaysNotSet {
+				return fmt.Errorf("At least one action must be specified in the `base_blob` block for the rule %q", name)
+			}
+
+			// a blob which has already been tiered to archive, or deleted, would never be tiered to cool
+			if tierToCool != storageManagementPolicyDaysNotSet && tierToArchive != storageManagementPolicyDaysNotSet && tierToArchive <= tierToCool {
+				return fmt.Errorf("`tier_to_archive_after_days_since_modification_greater_than` (%d) must be greater than `tier_to_cool_after_days_since_modification_greater_than` (%d) for the rule %q", tierToArchive, tierToCool, name)
+			}
+
+			if deleteDays != storageManagementPolicyDaysNotSet {
+				if tierToCool != storageManagementPolicyDaysNotSet && deleteDays <= tierToCool {
+					return fmt.Errorf("`delete_after_days_since_modification_greater_than` (%d) must be greater than `tier_to_cool_after_days_since_modification_greater_than` (%d) for the rule %q", deleteDays, tierToCool, name)
+				}
+
+				if tierToArchive != storageManagementPolicyDaysNotSet && deleteDays <= tierToArchive {
+					return fmt.Errorf("`delete_after_days_since_modification_greater_than` (%d) must be greater than `tier_to_archive_after_days_since_modification_greater_than` (%d) for the rule %q", deleteDays, tierToArchive, name)
+				}
+			}
+
+			hasAction = true
+		}
+
+		if snapshotRaw := actions["snapshot"].([]interface{}); len(snapshotRaw) > 0 && snapshotRaw[0] != nil {
+			hasAction = true
+		}
+
+		if !hasAction {
+			return fmt.Errorf("At least one of `base_blob` or `snapshot` must be specified in the `actions` for the rule %q", name)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageManagementPolicyRules(input []interface{}) *[]storageManagementPolicyRule {
+	rules := make([]storageManagementPolicyRule, 0)
+
+	for _, r := range input {
+		if r == nil {
+			continue
+		}
+
+		rule := r.(map[string]interface{})
+
+		definition := storageManagementPolicyDefinition{
+			Actions: &storageManagementPolicyActions{},
+			Filters: &storageManagementPolicyFilters{},
+		}
+
+		if filtersRaw := rule["filters"].([]interface{}); len(filtersRaw) > 0 && filtersRaw[0] != nil {
+			filters := filtersRaw[0].(map[string]interface{})
+
+			if prefixMatch := filters["prefix_match"].(*schema.Set).List(); len(prefixMatch) > 0 {
+				definition.Filters.PrefixMatch = utils.ExpandStringArray(prefixMatch)
+			}
+
+			definition.Filters.BlobTypes = utils.ExpandStringArray(filters["blob_types"].(*schema.Set).List())
+		}
+
+		if actionsRaw := rule["actions"].([]interface{}); len(actionsRaw) > 0 && actionsRaw[0] != nil {
+			actions := actionsRaw[0].(map[string]interface{})
+
+			if baseBlobRaw := actions["base_blob"].([]interface{}); len(baseBlobRaw) > 0 && baseBlobRaw[0] != nil {
+				baseBlob := baseBlobRaw[0].(map[string]interface{})
+
+				definition.Actions.BaseBlob = &storageManagementPolicyBaseBlob{
+					TierToCool:    expandStorageManagementPolicyDaysAfterModification(baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int)),
+					TierToArchive: expandStorageManagementPolicyDaysAfterModification(baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int)),
+					Delete:        expandStorageManagementPolicyDaysAfterModification(baseBlob["delete_after_days_since_modification_greater_than"].(int)),
+				}
+			}
+
+			if snapshotRaw := actions["snapshot"].([]interface{}); len(snapshotRaw) > 0 && snapshotRaw[0] != nil {
+				snapshot := snapshotRaw[0].(map[string]interface{})
+
+				definition.Actions.Snapshot = &storageManagementPolicySnapshot{
+					Delete: &storageManagementPolicyDaysAfterCreation{
+						DaysAfterCreationGreaterThan: utils.Int32(int32(snapshot["delete_after_days_since_creation_greater_than"].(int))),
+					},
+				}
+			}
+		}
+
+		rules = append(rules, storageManagementPolicyRule{
+			Name:       utils.String(rule["name"].(string)),
+			Enabled:    utils.Bool(rule["enabled"].(bool)),
+			Type:       utils.String("Lifecycle"),
+			Definition: &definition,
+		})
+	}
+
+	return &rules
+}
+
+func expandStorageManagementPolicyDaysAfterModification(days int) *storageManagementPolicyDaysAfterModification {
+	if days == storageManagementPolicyDaysNotSet {
+		return nil
+	}
+
+	return &storageManagementPolicyDaysAfterModification{
+		DaysAfterModificationGreaterThan: utils.Int32(int32(days)),
+	}
+}
+
+func flattenStorageManagementPolicyRules(input *[]storageManagementPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		enabled := false
+		if rule.Enabled != nil {
+			enabled = *rule.Enabled
+		}
+
+		filters := make([]interface{}, 0)
+		actions := make([]interface{}, 0)
+
+		if definition := rule.Definition; definition != nil {
+			if f := definition.Filters; f != nil {
+				prefixMatch := make([]interface{}, 0)
+				if f.PrefixMatch != nil {
+					prefixMatch = utils.FlattenStringArray(f.PrefixMatch)
+				}
+
+				blobTypes := make([]interface{}, 0)
+				if f.BlobTypes != nil {
+					blobTypes = utils.FlattenStringArray(f.BlobTypes)
+				}
+
+				filters = append(filters, map[string]interface{}{
+					"prefix_match": schema.NewSet(schema.HashString, prefixMatch),
+					"blob_types":   schema.NewSet(schema.HashString, blobTypes),
+				})
+			}
+
+			if a := definition.Actions; a != nil {
+				baseBlob := make([]interface{}, 0)
+				if b := a.BaseBlob; b != nil {
+					baseBlob = append(baseBlob, map[string]interface{}{
+						"tier_to_cool_after_days_since_modification_greater_than":    flattenStorageManagementPolicyDaysAfterModification(b.TierToCool),
+						"tier_to_archive_after_days_since_modification_greater_than": flattenStorageManagementPolicyDaysAfterModification(b.TierToArchive),
+						"delete_after_days_since_modification_greater_than":          flattenStorageManagementPolicyDaysAfterModification(b.Delete),
+					})
+				}
+
+				snapshot := make([]interface{}, 0)
+				if s := a.Snapshot; s != nil {
+					days := 0
+					if s.Delete != nil && s.Delete.DaysAfterCreationGreaterThan != nil {
+						days = int(*s.Delete.DaysAfterCreationGreaterThan)
+					}
+
+					snapshot = append(snapshot, map[string]interface{}{
+						"delete_after_days_since_creation_greater_than": days,
+					})
+				}
+
+				actions = append(actions, map[string]interface{}{
+					"base_blob": baseBlob,
+					"snapshot":  snapshot,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    name,
+			"enabled": enabled,
+			"filters": filters,
+			"actions": actions,
+		})
+	}
+
+	return results
+}
+
+func flattenStorageManagementPolicyDaysAfterModification(input *storageManagementPolicyDaysAfterModification) int {
+	if input == nil || input.DaysAfterModificationGreaterThan == nil {
+		return storageManagementPolicyDaysNotSet
+	}
+
+	return int(*input.DaysAfterModificationGreaterThan)
+}
@@ -0,0 +1,573 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/parse"
+)
+
+func TestValidateStorageManagementPolicyRules(t *testing.T) {
+	rule := func(name string, baseBlob []interface{}, snapshot []interface{}) interface{} {
+		return map[string]interface{}{
+			"name":    name,
+			"enabled": true,
+			"actions": []interface{}{
+				map[string]interface{}{
+					"base_blob": baseBlob,
+					"snapshot":  snapshot,
+				},
+			},
+		}
+	}
+	baseBlob := func(tierToCool, tierToArchive, deleteDays int) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"tier_to_cool_after_days_since_modification_greater_than":    tierToCool,
+				"tier_to_archive_after_days_since_modification_greater_than": tierToArchive,
+				"delete_after_days_since_modification_greater_than":          deleteDays,
+			},
+		}
+	}
+	snapshot := func(deleteDays int) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"delete_after_days_since_creation_greater_than": deleteDays,
+			},
+		}
+	}
+	notSet := storageManagementPolicyDaysNotSet
+
+	cases := []struct {
+		Name        string
+		Rules       []interface{}
+		ShouldError bool
+	}{
+		{
+			Name:        "No Rules",
+			Rules:       []interface{}{},
+			ShouldError: false,
+		},
+		{
+			Name: "All Actions",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(10, 50, 100), snapshot(30)),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Immediately Tier to Cool",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(0, notSet, notSet), []interface{}{}),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Snapshot Only",
+			Rules: []interface{}{
+				rule("rule1", []interface{}{}, snapshot(30)),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Multiple Rules",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(10, notSet, notSet), []interface{}{}),
+				rule("rule2", baseBlob(notSet, notSet, 100), []interface{}{}),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Duplicate Names",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(10, notSet, notSet), []interface{}{}),
+				rule("rule1", baseBlob(notSet, notSet, 100), []interface{}{}),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "No Actions",
+			Rules: []interface{}{
+				map[string]interface{}{
+					"name":    "rule1",
+					"enabled": true,
+					"actions": []interface{}{},
+				},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Empty Actions",
+			Rules: []interface{}{
+				rule("rule1", []interface{}{}, []interface{}{}),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Empty Base Blob",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(notSet, notSet, notSet), []interface{}{}),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Tier to Archive before Cool",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(50, 10, notSet), []interface{}{}),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Tier to Archive and Cool at the same time",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(10, 10, notSet), []interface{}{}),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Delete before Cool",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(50, notSet, 10), []interface{}{}),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Delete before Archive",
+			Rules: []interface{}{
+				rule("rule1", baseBlob(notSet, 50, 10), []interface{}{}),
+			},
+			ShouldError: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateStorageManagementPolicyRules(v.Rules)
+		if v.ShouldError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ShouldError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestExpandFlattenStorageManagementPolicyRules(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":    "rule1",
+			"enabled": true,
+			"filters": []interface{}{
+				map[string]interface{}{
+					"prefix_match": schema.NewSet(schema.HashString, []interface{}{"container1/prefix1"}),
+					"blob_types":   schema.NewSet(schema.HashString, []interface{}{"blockBlob"}),
+				},
+			},
+			"actions": []interface{}{
+				map[string]interface{}{
+					"base_blob": []interface{}{
+						map[string]interface{}{
+							"tier_to_cool_after_days_since_modification_greater_than":    0,
+							"tier_to_archive_after_days_since_modification_greater_than": 50,
+							"delete_after_days_since_modification_greater_than":          storageManagementPolicyDaysNotSet,
+						},
+					},
+					"snapshot": []interface{}{},
+				},
+			},
+		},
+		map[string]interface{}{
+			"name":    "rule2",
+			"enabled": false,
+			"filters": []interface{}{
+				map[string]interface{}{
+					"prefix_match": schema.NewSet(schema.HashString, []interface{}{}),
+					"blob_types":   schema.NewSet(schema.HashString, []interface{}{"blockBlob"}),
+				},
+			},
+			"actions": []interface{}{
+				map[string]interface{}{
+					"base_blob": []interface{}{},
+					"snapshot": []interface{}{
+						map[string]interface{}{
+							"delete_after_days_since_creation_greater_than": 30,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expanded := expandStorageManagementPolicyRules(input)
+	if len(*expanded) != 2 {
+		t.Fatalf("Expected 2 rules but got %d", len(*expanded))
+	}
+
+	baseBlob := (*expanded)[0].Definition.Actions.BaseBlob
+	if baseBlob.TierToCool == nil || *baseBlob.TierToCool.DaysAfterModificationGreaterThan != 0 {
+		t.Fatalf("Expected `tierToCool` to be set to 0 but got %+v", baseBlob.TierToCool)
+	}
+	if baseBlob.Delete != nil {
+		t.Fatalf("Expected `delete` not to be set but got %+v", baseBlob.Delete)
+	}
+	if (*expanded)[1].Definition.Filters.PrefixMatch != nil {
+		t.Fatalf("Expected `prefixMatch` not to be set but got %+v", *(*expanded)[1].Definition.Filters.PrefixMatch)
+	}
+
+	flattened := flattenStorageManagementPolicyRules(expanded)
+	if len(flattened) != len(input) {
+		t.Fatalf("Expected %d rules but got %d", len(input), len(flattened))
+	}
+
+	for i := range input {
+		expected := input[i].(map[string]interface{})
+		actual := flattened[i].(map[string]interface{})
+
+		for _, key := range []string{"name", "enabled", "actions"} {
+			if !reflect.DeepEqual(expected[key], actual[key]) {
+				t.Fatalf("Expected %q for rule %d to be %+v but got %+v", key, i, expected[key], actual[key])
+			}
+		}
+
+		expectedFilters := expected["filters"].([]interface{})[0].(map[string]interface{})
+		actualFilters := actual["filters"].([]interface{})[0].(map[string]interface{})
+		for _, key := range []string{"prefix_match", "blob_types"} {
+			if !expectedFilters[key].(*schema.Set).Equal(actualFilters[key].(*schema.Set)) {
+				t.Fatalf("Expected %q for rule %d to be %+v but got %+v", key, i, expectedFilters[key], actualFilters[key])
+			}
+		}
+	}
+}
+
+func TestAccAzureRMStorageManagementPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "rule1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filters.0.prefix_match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filters.0.blob_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", "50"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.delete_after_days_since_modification_greater_than", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.snapshot.0.delete_after_days_since_creation_greater_than", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_requiresImport(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageManagementPolicy_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_management_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_update(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageManagementPolicy_multipleRules(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.snapshot.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.name", "rule2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filters.0.prefix_match.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.actions.0.base_blob.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_disappears(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					testCheckAzureRMStorageManagementPolicyDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageManagementPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseStorageManagementPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).storage().storageManagementPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Management Policy for Storage Account %q (Resource Group %q) does not exist", id.StorageAccountName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storageManagementPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageManagementPolicyDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.ParseStorageManagementPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).storage().storageManagementPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if _, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName); err != nil {
+			return fmt.Errorf("Bad: Delete on storageManagementPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageManagementPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storage().storageManagementPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_management_policy" {
+			continue
+		}
+
+		id, err := parse.ParseStorageManagementPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+		if err != nil {
+			// the Storage Account is deleted alongside the Management Policy
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Management Policy for Storage Account %q (Resource Group %q) still exists", id.StorageAccountName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageManagementPolicy_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageManagementPolicy_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name    = "rule1"
+    enabled = true
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than    = 10
+        tier_to_archive_after_days_since_modification_greater_than = 50
+        delete_after_days_since_modification_greater_than          = 100
+      }
+
+      snapshot {
+        delete_after_days_since_creation_greater_than = 30
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "import" {
+  storage_account_id = "${azurerm_storage_management_policy.test.storage_account_id}"
+
+  rule {
+    name    = "rule1"
+    enabled = true
+
+    filters {
+      blob_types = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        delete_after_days_since_modification_greater_than = 100
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_multipleRules(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name    = "rule1"
+    enabled = false
+
+    filters {
+      prefix_match = ["container1/prefix1", "container2/prefix2"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than = 0
+        delete_after_days_since_modification_greater_than       = 365
+      }
+    }
+  }
+
+  rule {
+    name    = "rule2"
+    enabled = true
+
+    filters {
+      blob_types = ["blockBlob"]
+    }
+
+    actions {
+      snapshot {
+        delete_after_days_since_creation_greater_than = 7
+      }
+    }
+  }
+}
+`, template)
+}
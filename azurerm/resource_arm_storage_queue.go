@@ -6,15 +6,19 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageQueueCreate,
 		Read:   resourceArmStorageQueueRead,
+		Update: resourceArmStorageQueueUpdate,
 		Delete: resourceArmStorageQueueDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -22,6 +26,13 @@ func resourceArmStorageQueue() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageQueueMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -35,6 +46,8 @@ func resourceArmStorageQueue() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"acl": storageAccessControlListSchema("raup"),
 		},
 	}
 }
@@ -70,7 +83,8 @@ func validateArmStorageQueueName(v interface{}, k string) (warnings []string, er
 
 func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	environment := armClient.environment
 
 	name := d.Get("name").(string)
@@ -87,27 +101,55 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[INFO] Creating queue %q in storage account %q", name, storageAccountName)
 	queueReference := queueClient.GetQueueReference(name)
+
+	id := fmt.Sprintf("https://%s.queue.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
+	if armClient.requireResourcesToBeImported && d.IsNewResource() {
+		exists, err := queueReference.Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Queue %q (Storage Account %q): %s", name, storageAccountName, err)
+		}
+
+		if exists {
+			return tf.ImportAsExistsError("azurerm_storage_queue", id)
+		}
+	}
+
 	options := &storage.QueueServiceOptions{}
 	err = queueReference.Create(options)
 	if err != nil {
 		return fmt.Errorf("Error creating storage queue on Azure: %s", err)
 	}
 
-	id := fmt.Sprintf("https://%s.queue.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
+	if acl := d.Get("acl").(*schema.Set).List(); len(acl) > 0 {
+		aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "queue", "")
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		log.Printf("[INFO] Setting ACL for queue %q in storage account %q", name, storageAccountName)
+		if err := aclClient.Set(ctx, name, expandStorageAccessControlList(acl)); err != nil {
+			return fmt.Errorf("Error setting ACL for storage queue %q: %+v", name, err)
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageQueueRead(d, meta)
 }
 
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageQueueID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -145,19 +187,68 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", *resourceGroup)
 
+	aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName, "queue", "")
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing queue %q from state", id.storageAccountName, id.queueName)
+		d.SetId("")
+		return nil
+	}
+
+	acl, err := aclClient.Get(ctx, id.queueName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL for storage queue %q: %+v", id.queueName, err)
+	}
+	if err := d.Set("acl", flattenStorageAccessControlList(acl)); err != nil {
+		return fmt.Errorf("Error setting `acl`: %+v", err)
+	}
+
 	return nil
 }
 
+func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName, "queue", "")
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	if d.HasChange("acl") {
+		log.Printf("[INFO] Updating ACL for queue %q in storage account %q", id.queueName, id.storageAccountName)
+		acl := expandStorageAccessControlList(d.Get("acl").(*schema.Set).List())
+		if err := aclClient.Set(ctx, id.queueName, acl); err != nil {
+			return fmt.Errorf("Error updating ACL for storage queue %q: %+v", id.queueName, err)
+		}
+	}
+
+	return resourceArmStorageQueueRead(d, meta)
+}
+
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageQueueID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -5,11 +5,14 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageShare() *schema.Resource {
@@ -24,6 +27,13 @@ func resourceArmStorageShare() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageShareMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -43,6 +53,7 @@ func resourceArmStorageShare() *schema.Resource {
 				Default:      5120,
 				ValidateFunc: validation.IntBetween(1, 5120),
 			},
+			"acl": storageAccessControlListSchema("rcwdl"),
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -52,7 +63,8 @@ func resourceArmStorageShare() *schema.Resource {
 }
 func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
@@ -71,6 +83,19 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[INFO] Creating share %q in storage account %q", name, storageAccountName)
 	reference := fileClient.GetShareReference(name)
+
+	id := fmt.Sprintf("%s/%s/%s", name, resourceGroupName, storageAccountName)
+	if armClient.requireResourcesToBeImported && d.IsNewResource() {
+		exists, err := reference.Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Share %q (Storage Account %q): %s", name, storageAccountName, err)
+		}
+
+		if exists {
+			return tf.ImportAsExistsError("azurerm_storage_share", id)
+		}
+	}
+
 	err = reference.Create(options)
 	if err != nil {
 		return fmt.Errorf("Error creating Storage Share %q reference (storage account: %q) : %+v", name, storageAccountName, err)
@@ -90,13 +115,29 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error setting properties on Storage Share %q: %+v", name, err)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s/%s", name, resourceGroupName, storageAccountName))
+	if acl := d.Get("acl").(*schema.Set).List(); len(acl) > 0 {
+		aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "file", "share")
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		log.Printf("[INFO] Setting share %q ACL in storage account %q", name, storageAccountName)
+		if err := aclClient.Set(ctx, name, expandStorageAccessControlList(acl)); err != nil {
+			return fmt.Errorf("Error setting ACL on Storage Share %q: %+v", name, err)
+		}
+	}
+
+	d.SetId(id)
 	return resourceArmStorageShareRead(d, meta)
 }
 
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -142,12 +183,31 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("quota", reference.Properties.Quota)
 
+	aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "file", "share")
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing share %q from state", storageAccountName, name)
+		d.SetId("")
+		return nil
+	}
+
+	acl, err := aclClient.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL on Storage Share %q: %+v", name, err)
+	}
+	if err := d.Set("acl", flattenStorageAccessControlList(acl)); err != nil {
+		return fmt.Errorf("Error setting `acl`: %+v", err)
+	}
+
 	return nil
 }
 
 func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -169,12 +229,30 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 
 	reference := fileClient.GetShareReference(name)
 
-	log.Printf("[INFO] Setting share %q properties in storage account %q", name, storageAccountName)
-	reference.Properties = storage.ShareProperties{
-		Quota: d.Get("quota").(int),
+	if d.HasChange("quota") {
+		log.Printf("[INFO] Setting share %q properties in storage account %q", name, storageAccountName)
+		reference.Properties = storage.ShareProperties{
+			Quota: d.Get("quota").(int),
+		}
+		if err := reference.SetProperties(options); err != nil {
+			return fmt.Errorf("Error setting properties on Storage Share %q: %+v", name, err)
+		}
 	}
-	if err := reference.SetProperties(options); err != nil {
-		return fmt.Errorf("Error setting properties on Storage Share %q: %+v", name, err)
+
+	if d.HasChange("acl") {
+		aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "file", "share")
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		log.Printf("[INFO] Setting share %q ACL in storage account %q", name, storageAccountName)
+		acl := expandStorageAccessControlList(d.Get("acl").(*schema.Set).List())
+		if err := aclClient.Set(ctx, name, acl); err != nil {
+			return fmt.Errorf("Error setting ACL on Storage Share %q: %+v", name, err)
+		}
 	}
 
 	return resourceArmStorageShareRead(d, meta)
@@ -182,7 +260,8 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -212,7 +291,7 @@ func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-//Following the naming convention as laid out in the docs https://msdn.microsoft.com/library/azure/dn167011.aspx
+// Following the naming convention as laid out in the docs https://msdn.microsoft.com/library/azure/dn167011.aspx
 func validateArmStorageShareName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
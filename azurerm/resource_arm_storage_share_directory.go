@@ -0,0 +1,259 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+)
+
+func resourceArmStorageShareDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageShareDirectoryCreate,
+		Read:   resourceArmStorageShareDirectoryRead,
+		Delete: resourceArmStorageShareDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareDirectoryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+	shareName := d.Get("share_name").(string)
+
+	log.Printf("[INFO] Creating directory %q in share %q within storage account %q", name, shareName, storageAccountName)
+	share := fileClient.GetShareReference(shareName)
+	directory := share.GetRootDirectoryReference().GetDirectoryReference(name)
+
+	// gives us https://example.file.core.windows.net/share/directory
+	id := fmt.Sprintf("https://%s.file.%s/%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, shareName, name)
+	if armClient.requireResourcesToBeImported && d.IsNewResource() {
+		exists, err := directory.Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Directory %q (Share %q / Storage Account %q): %s", name, shareName, storageAccountName, err)
+		}
+
+		if exists {
+			return tf.ImportAsExistsError("azurerm_storage_share_directory", id)
+		}
+	}
+
+	if err := directory.Create(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error creating Directory %q (Share %q / Storage Account %q): %+v", name, shareName, storageAccountName, err)
+	}
+
+	d.SetId(id)
+	return resourceArmStorageShareDirectoryRead(d, meta)
+}
+
+func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageShareDirectoryID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to determine Resource Group for Storage Account %q, removing directory %q from state", id.storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing directory %q from state", id.storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	share := fileClient.GetShareReference(id.shareName)
+	directory := share.GetRootDirectoryReference().GetDirectoryReference(id.directoryName)
+	exists, err := directory.Exists()
+	if err != nil {
+		return fmt.Errorf("Error checking for existence of Directory %q (Share %q / Storage Account %q): %s", id.directoryName, id.shareName, id.storageAccountName, err)
+	}
+
+	if !exists {
+		log.Printf("[INFO] Directory %q (Share %q / Storage Account %q) no longer exists, removing from state...", id.directoryName, id.shareName, id.storageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.directoryName)
+	d.Set("share_name", id.shareName)
+	d.Set("storage_account_name", id.storageAccountName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("url", directory.URL())
+
+	return nil
+}
+
+func resourceArmStorageShareDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageShareDirectoryID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
+	if err != nil {
+		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q: %+v", id.storageAccountName, err)
+	}
+	if resourceGroup == nil {
+		log.Printf("[INFO] Resource Group doesn't exist so the directory won't exist")
+		return nil
+	}
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the directory won't exist", id.storageAccountName)
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Directory %q (Share %q / Storage Account %q)", id.directoryName, id.shareName, id.storageAccountName)
+	share := fileClient.GetShareReference(id.shareName)
+	directory := share.GetRootDirectoryReference().GetDirectoryReference(id.directoryName)
+	if _, err := directory.DeleteIfExists(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error deleting Directory %q (Share %q / Storage Account %q): %s", id.directoryName, id.shareName, id.storageAccountName, err)
+	}
+
+	return nil
+}
+
+type storageShareDirectoryId struct {
+	storageAccountName string
+	shareName          string
+	directoryName      string
+}
+
+func parseStorageShareDirectoryID(input string, environment azure.Environment) (*storageShareDirectoryId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as URI: %+v", input, err)
+	}
+
+	// trim the leading `/`
+	segments := strings.SplitN(strings.TrimPrefix(uri.Path, "/"), "/", 2)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return nil, fmt.Errorf("Expected the path of %q to be in the format `/{shareName}/{directoryName}`", input)
+	}
+
+	id := storageShareDirectoryId{
+		storageAccountName: strings.Replace(uri.Host, fmt.Sprintf(".file.%s", environment.StorageEndpointSuffix), "", 1),
+		shareName:          segments[0],
+		directoryName:      segments[1],
+	}
+	return &id, nil
+}
+
+// validateArmStorageShareDirectoryName validates the path of a Directory within a Share, where each of the
+// Directories in the path follows the naming convention in https://docs.microsoft.com/en-us/rest/api/storageservices/naming-and-referencing-shares--directories--files--and-metadata
+func validateArmStorageShareDirectoryName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q cannot begin or end with a slash: %q", k, value))
+		return warnings, errors
+	}
+
+	for _, segment := range strings.Split(value, "/") {
+		_, segmentErrors := validateArmStorageShareFileName(segment, k)
+		errors = append(errors, segmentErrors...)
+	}
+
+	return warnings, errors
+}
+
+// validateArmStorageShareFileName validates the name of a single Directory or File within a Share
+func validateArmStorageShareFileName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 || len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 255 characters: %q", k, value))
+	}
+
+	if strings.ContainsAny(value, `"\/:|<>*?`) {
+		errors = append(errors, fmt.Errorf(`%q cannot contain any of the characters " \ / : | < > * ?: %q`, k, value))
+	}
+
+	if strings.HasSuffix(value, ".") {
+		errors = append(errors, fmt.Errorf("%q cannot end with a dot: %q", k, value))
+	}
+
+	return warnings, errors
+}
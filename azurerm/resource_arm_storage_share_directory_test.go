@@ -0,0 +1,392 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStorageShareDirectory_basic(t *testing.T) {
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_requiresImport(t *testing.T) {
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageShareDirectory_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_share_directory"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_nested(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_nested(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists("azurerm_storage_share_directory.parent"),
+					testCheckAzureRMStorageShareDirectoryExists("azurerm_storage_share_directory.child"),
+					resource.TestCheckResourceAttr("azurerm_storage_share_directory.child", "name", "parent/child"),
+				),
+			},
+			{
+				ResourceName:      "azurerm_storage_share_directory.child",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_disappears(t *testing.T) {
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+					testCheckAzureRMStorageShareDirectoryDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageShareDirectoryExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for storage share directory: %s", name)
+		}
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		directory := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+		exists, err := directory.Exists()
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("Bad: Directory %q (Share %q / Storage Account %q) does not exist", name, shareName, storageAccountName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareDirectoryDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for storage share directory: %s", name)
+		}
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		directory := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+		if err := directory.Delete(nil); err != nil {
+			return fmt.Errorf("Error deleting Directory %q (Share %q / Storage Account %q): %+v", name, shareName, storageAccountName, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareDirectoryDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_share_directory" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for storage share directory: %s", name)
+		}
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			// if we can't get the keys then the directory can't exist
+			return nil
+		}
+		if !accountExists {
+			return nil
+		}
+
+		directory := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+		exists, err := directory.Exists()
+		if err != nil {
+			return nil
+		}
+
+		if exists {
+			return fmt.Errorf("Bad: Directory %q (Share %q / Storage Account %q) still exists", name, shareName, storageAccountName)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageShareDirectory_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageShareDirectory_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "import" {
+  name                 = "${azurerm_storage_share_directory.test.name}"
+  resource_group_name  = "${azurerm_storage_share_directory.test.resource_group_name}"
+  storage_account_name = "${azurerm_storage_share_directory.test.storage_account_name}"
+  share_name           = "${azurerm_storage_share_directory.test.share_name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_nested(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "parent" {
+  name                 = "parent"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+
+resource "azurerm_storage_share_directory" "child" {
+  name                 = "${azurerm_storage_share_directory.parent.name}/child"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+`, template)
+}
+
+func TestParseStorageShareDirectoryID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *storageShareDirectoryId
+	}{
+		{
+			Input:    "https://example.file.core.windows.net/share",
+			Expected: nil,
+		},
+		{
+			Input:    "https://example.file.core.windows.net/share/",
+			Expected: nil,
+		},
+		{
+			Input: "https://example.file.core.windows.net/share/directory",
+			Expected: &storageShareDirectoryId{
+				storageAccountName: "example",
+				shareName:          "share",
+				directoryName:      "directory",
+			},
+		},
+		{
+			Input: "https://example.file.core.windows.net/share/parent/child",
+			Expected: &storageShareDirectoryId{
+				storageAccountName: "example",
+				shareName:          "share",
+				directoryName:      "parent/child",
+			},
+		},
+		{
+			Input: "https://example.file.core.windows.net/share/with space",
+			Expected: &storageShareDirectoryId{
+				storageAccountName: "example",
+				shareName:          "share",
+				directoryName:      "with space",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseStorageShareDirectoryID(v.Input, azure.PublicCloud)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", *actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestValidateArmStorageShareDirectoryName(t *testing.T) {
+	validNames := []string{
+		"directory",
+		"parent/child",
+		"with space",
+		"a/b/c/d",
+		strings.Repeat("w", 255),
+	}
+	for _, v := range validNames {
+		_, errors := validateArmStorageShareDirectoryName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Directory Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"/leading",
+		"trailing/",
+		"double//slash",
+		"invalid:name",
+		"invalid*name",
+		"parent/invalid?",
+		"dot.",
+		strings.Repeat("w", 256),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateArmStorageShareDirectoryName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Directory Name", v)
+		}
+	}
+}
@@ -0,0 +1,443 @@
+package azurerm
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageShareFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageShareFileCreate,
+		Read:   resourceArmStorageShareFileRead,
+		Update: resourceArmStorageShareFileUpdate,
+		Delete: resourceArmStorageShareFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareFileName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: validateArmStorageShareFilePath,
+			},
+
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "application/octet-stream",
+			},
+
+			"content_md5": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9a-fA-F]{32}$`),
+					"The `content_md5` must be a hex encoded MD5 hash, such as the output of the `md5` function.",
+				),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmStorageShareFileCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+	shareName := d.Get("share_name").(string)
+	path := d.Get("path").(string)
+	source := d.Get("source").(string)
+	contentType := d.Get("content_type").(string)
+	contentMD5 := d.Get("content_md5").(string)
+
+	log.Printf("[INFO] Creating file %q in share %q within storage account %q", name, shareName, storageAccountName)
+	file := storageShareFileReference(fileClient, shareName, path, name)
+
+	// gives us https://example.file.core.windows.net/share/path/file.txt
+	id := fmt.Sprintf("https://%s.file.%s/%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, shareName, name)
+	if path != "" {
+		id = fmt.Sprintf("https://%s.file.%s/%s/%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, shareName, path, name)
+	}
+
+	if armClient.requireResourcesToBeImported && d.IsNewResource() {
+		exists, err := file.Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing File %q (Share %q / Storage Account %q): %s", name, shareName, storageAccountName, err)
+		}
+
+		if exists {
+			return tf.ImportAsExistsError("azurerm_storage_share_file", id)
+		}
+	}
+
+	if source != "" {
+		if err := resourceArmStorageShareFileUploadFromSource(file, source, contentType, contentMD5); err != nil {
+			return fmt.Errorf("Error creating File %q (Share %q / Storage Account %q): %s", name, shareName, storageAccountName, err)
+		}
+	} else {
+		file.Properties.Type = contentType
+		if contentMD5 != "" {
+			encodedMD5, err := convertHexToBase64Encoding(contentMD5)
+			if err != nil {
+				return err
+			}
+			file.Properties.MD5 = encodedMD5
+		}
+
+		if err := file.Create(0, &storage.FileRequestOptions{}); err != nil {
+			return fmt.Errorf("Error creating File %q (Share %q / Storage Account %q): %s", name, shareName, storageAccountName, err)
+		}
+	}
+
+	d.SetId(id)
+	return resourceArmStorageShareFileRead(d, meta)
+}
+
+// resourceArmStorageShareFileUploadFromSource uploads the contents of the local file in ranges of up to 4MB, then sets
+// the Content-MD5 - which is calculated from the local file when it's not specified
+func resourceArmStorageShareFileUploadFromSource(file *storage.File, source, contentType, contentMD5 string) error {
+	input, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
+	}
+	defer utils.IoCloseAndLogError(input, fmt.Sprintf("Error closing Storage Share File `%s` file `%s` after upload", file.Name, source))
+
+	info, err := input.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", source, err)
+	}
+	size := uint64(info.Size())
+
+	if contentMD5 == "" {
+		hash := md5.New()
+		if _, err := io.Copy(hash, input); err != nil {
+			return fmt.Errorf("Error calculating the MD5 of the source file %q: %s", source, err)
+		}
+		contentMD5 = hex.EncodeToString(hash.Sum(nil))
+
+		if _, err := input.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("Error reading source file %q: %s", source, err)
+		}
+	}
+
+	encodedMD5, err := convertHexToBase64Encoding(contentMD5)
+	if err != nil {
+		return err
+	}
+
+	file.Properties.Type = contentType
+	if err := file.Create(size, &storage.FileRequestOptions{}); err != nil {
+		return err
+	}
+
+	chunk := make([]byte, storage.MaxRangeSize)
+	for offset := uint64(0); offset < size; offset += storage.MaxRangeSize {
+		n, err := io.ReadFull(input, chunk)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return fmt.Errorf("Error reading source file %q at offset %d: %s", source, offset, err)
+		}
+
+		fileRange := storage.FileRange{
+			Start: offset,
+			End:   offset + uint64(n) - 1,
+		}
+		if err := file.WriteRange(bytes.NewReader(chunk[:n]), fileRange, nil); err != nil {
+			return fmt.Errorf("Error writing range at offset %d for file %q: %s", offset, source, err)
+		}
+	}
+
+	file.Properties.MD5 = encodedMD5
+	return file.SetProperties(&storage.FileRequestOptions{})
+}
+
+func resourceArmStorageShareFileRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageShareFileID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to determine Resource Group for Storage Account %q, removing file %q from state", id.storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing file %q from state", id.storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	file := storageShareFileReference(fileClient, id.shareName, id.path, id.fileName)
+	exists, err := file.Exists()
+	if err != nil {
+		return fmt.Errorf("Error checking for existence of File %q (Share %q / Storage Account %q): %s", id.fileName, id.shareName, id.storageAccountName, err)
+	}
+
+	if !exists {
+		log.Printf("[INFO] File %q (Share %q / Storage Account %q) no longer exists, removing from state...", id.fileName, id.shareName, id.storageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	if err := file.FetchAttributes(nil); err != nil {
+		return fmt.Errorf("Error retrieving properties of File %q (Share %q / Storage Account %q): %+v", id.fileName, id.shareName, id.storageAccountName, err)
+	}
+
+	d.Set("name", id.fileName)
+	d.Set("path", id.path)
+	d.Set("share_name", id.shareName)
+	d.Set("storage_account_name", id.storageAccountName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("content_type", file.Properties.Type)
+	d.Set("url", file.URL())
+
+	contentMD5 := ""
+	if file.Properties.MD5 != "" {
+		contentMD5, err = convertBase64ToHexEncoding(file.Properties.MD5)
+		if err != nil {
+			return fmt.Errorf("Error parsing the Content-MD5 of File %q (Share %q / Storage Account %q): %+v", id.fileName, id.shareName, id.storageAccountName, err)
+		}
+	}
+	d.Set("content_md5", contentMD5)
+
+	return nil
+}
+
+func resourceArmStorageShareFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageShareFileID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+
+	if resourceGroup == nil {
+		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q", id.storageAccountName)
+	}
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	file := storageShareFileReference(fileClient, id.shareName, id.path, id.fileName)
+
+	if d.HasChange("content_type") {
+		// the existing properties are retrieved first, since any which aren't specified are cleared
+		if err := file.FetchAttributes(nil); err != nil {
+			return fmt.Errorf("Error retrieving properties of File %q (Share %q / Storage Account %q): %+v", id.fileName, id.shareName, id.storageAccountName, err)
+		}
+
+		file.Properties.Type = d.Get("content_type").(string)
+		if err := file.SetProperties(&storage.FileRequestOptions{}); err != nil {
+			return fmt.Errorf("Error setting properties of File %q (Share %q / Storage Account %q): %+v", id.fileName, id.shareName, id.storageAccountName, err)
+		}
+	}
+
+	return resourceArmStorageShareFileRead(d, meta)
+}
+
+func resourceArmStorageShareFileDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageShareFileID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
+	if err != nil {
+		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q: %+v", id.storageAccountName, err)
+	}
+	if resourceGroup == nil {
+		log.Printf("[INFO] Resource Group doesn't exist so the file won't exist")
+		return nil
+	}
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the file won't exist", id.storageAccountName)
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting File %q (Share %q / Storage Account %q)", id.fileName, id.shareName, id.storageAccountName)
+	file := storageShareFileReference(fileClient, id.shareName, id.path, id.fileName)
+	if _, err := file.DeleteIfExists(&storage.FileRequestOptions{}); err != nil {
+		return fmt.Errorf("Error deleting File %q (Share %q / Storage Account %q): %s", id.fileName, id.shareName, id.storageAccountName, err)
+	}
+
+	return nil
+}
+
+func storageShareFileReference(client *storage.FileServiceClient, shareName, path, name string) *storage.File {
+	directory := client.GetShareReference(shareName).GetRootDirectoryReference()
+	if path != "" {
+		directory = directory.GetDirectoryReference(path)
+	}
+
+	return directory.GetFileReference(name)
+}
+
+type storageShareFileId struct {
+	storageAccountName string
+	shareName          string
+	path               string
+	fileName           string
+}
+
+func parseStorageShareFileID(input string, environment azure.Environment) (*storageShareFileId, error) {
+	directoryId, err := parseStorageShareDirectoryID(input, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	// the File is either in the root of the Share, or within a Directory
+	path := ""
+	fileName := directoryId.directoryName
+	if i := strings.LastIndex(fileName, "/"); i != -1 {
+		path = fileName[:i]
+		fileName = fileName[i+1:]
+	}
+
+	id := storageShareFileId{
+		storageAccountName: directoryId.storageAccountName,
+		shareName:          directoryId.shareName,
+		path:               path,
+		fileName:           fileName,
+	}
+	return &id, nil
+}
+
+// validateArmStorageShareFilePath validates the path of the Directory containing a File, which is empty when the File
+// is in the root of the Share
+func validateArmStorageShareFilePath(v interface{}, k string) (warnings []string, errors []error) {
+	if v.(string) == "" {
+		return warnings, errors
+	}
+
+	return validateArmStorageShareDirectoryName(v, k)
+}
+
+// convertHexToBase64Encoding converts a hex encoded MD5 (as output by the `md5` function) to the base64 encoding used
+// for the Content-MD5 in Azure Storage
+func convertHexToBase64Encoding(input string) (string, error) {
+	decoded, err := hex.DecodeString(input)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding %q as hex: %+v", input, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(decoded), nil
+}
+
+func convertBase64ToHexEncoding(input string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding %q as base64: %+v", input, err)
+	}
+
+	return hex.EncodeToString(decoded), nil
+}
@@ -0,0 +1,580 @@
+package azurerm
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStorageShareFile_basic(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareFile_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareFile_requiresImport(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareFile_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageShareFile_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_share_file"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareFile_source(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	// larger than a single range, so that the file is uploaded in multiple requests
+	sourceFile, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source file")
+	}
+	defer os.Remove(sourceFile.Name())
+
+	hash := md5.New()
+	if _, err := io.CopyN(io.MultiWriter(sourceFile, hash), rand.Reader, 5*1024*1024+512); err != nil {
+		t.Fatalf("Failed to write random data to source file")
+	}
+
+	if err := sourceFile.Close(); err != nil {
+		t.Fatalf("Failed to close source file")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareFile_source(ri, rs, location, sourceFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileMatchesFile(resourceName, sourceFile.Name()),
+					resource.TestCheckResourceAttr(resourceName, "path", "config"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", hex.EncodeToString(hash.Sum(nil))),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareFile_contentMD5(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	sourceFile, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source file")
+	}
+	defer os.Remove(sourceFile.Name())
+
+	if _, err := sourceFile.WriteString("setting=value\n"); err != nil {
+		t.Fatalf("Failed to write to source file")
+	}
+
+	if err := sourceFile.Close(); err != nil {
+		t.Fatalf("Failed to close source file")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareFile_contentMD5(ri, rs, location, sourceFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileMatchesFile(resourceName, sourceFile.Name()),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(sourceFile.Name(), []byte("setting=updated\n"), 0644); err != nil {
+						t.Fatalf("Failed to update source file: %+v", err)
+					}
+				},
+				Config: testAccAzureRMStorageShareFile_contentMD5(ri, rs, location, sourceFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileMatchesFile(resourceName, sourceFile.Name()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareFile_updateContentType(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareFile_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageShareFile_contentType(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareFile_disappears(t *testing.T) {
+	resourceName := "azurerm_storage_share_file.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareFile_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareFileExists(resourceName),
+					testCheckAzureRMStorageShareFileDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageShareFileExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		path := rs.Primary.Attributes["path"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for storage share file: %s", name)
+		}
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		file := storageShareFileReference(fileClient, shareName, path, name)
+		exists, err := file.Exists()
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("Bad: File %q (Share %q / Storage Account %q) does not exist", name, shareName, storageAccountName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareFileMatchesFile(name string, filePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		path := rs.Primary.Attributes["path"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for storage share file: %s", name)
+		}
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		file := storageShareFileReference(fileClient, shareName, path, name)
+		body, err := file.DownloadToStream(nil)
+		if err != nil {
+			return fmt.Errorf("Error downloading File %q (Share %q / Storage Account %q): %+v", name, shareName, storageAccountName, err)
+		}
+		defer body.Close()
+
+		actual, err := ioutil.ReadAll(body)
+		if err != nil {
+			return err
+		}
+
+		expected, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(actual, expected) {
+			return fmt.Errorf("Bad: File %q (Share %q / Storage Account %q) does not match the contents of %q", name, shareName, storageAccountName, filePath)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareFileDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		path := rs.Primary.Attributes["path"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for storage share file: %s", name)
+		}
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		file := storageShareFileReference(fileClient, shareName, path, name)
+		if err := file.Delete(nil); err != nil {
+			return fmt.Errorf("Error deleting File %q (Share %q / Storage Account %q): %+v", name, shareName, storageAccountName, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareFileDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_share_file" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		path := rs.Primary.Attributes["path"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for storage share file: %s", name)
+		}
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			// if we can't get the keys then the file can't exist
+			return nil
+		}
+		if !accountExists {
+			return nil
+		}
+
+		file := storageShareFileReference(fileClient, shareName, path, name)
+		exists, err := file.Exists()
+		if err != nil {
+			return nil
+		}
+
+		if exists {
+			return fmt.Errorf("Bad: File %q (Share %q / Storage Account %q) still exists", name, shareName, storageAccountName)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageShareFile_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "test" {
+  name                 = "file.txt"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareFile_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareFile_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "import" {
+  name                 = "${azurerm_storage_share_file.test.name}"
+  resource_group_name  = "${azurerm_storage_share_file.test.resource_group_name}"
+  storage_account_name = "${azurerm_storage_share_file.test.storage_account_name}"
+  share_name           = "${azurerm_storage_share_file.test.share_name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareFile_contentType(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "test" {
+  name                 = "file.txt"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+  content_type         = "application/json"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareFile_source(rInt int, rString string, location string, source string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "config"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+
+resource "azurerm_storage_share_file" "test" {
+  name                 = "settings.bin"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+  path                 = "${azurerm_storage_share_directory.test.name}"
+  source               = "%s"
+  content_type         = "text/plain"
+}
+`, template, source)
+}
+
+func testAccAzureRMStorageShareFile_contentMD5(rInt int, rString string, location string, source string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "test" {
+  name                 = "settings.conf"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+  source               = "%s"
+  content_md5          = "${md5(file("%s"))}"
+}
+`, template, source, source)
+}
+
+func TestParseStorageShareFileID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *storageShareFileId
+	}{
+		{
+			Input:    "https://example.file.core.windows.net/share",
+			Expected: nil,
+		},
+		{
+			Input: "https://example.file.core.windows.net/share/file.txt",
+			Expected: &storageShareFileId{
+				storageAccountName: "example",
+				shareName:          "share",
+				path:               "",
+				fileName:           "file.txt",
+			},
+		},
+		{
+			Input: "https://example.file.core.windows.net/share/directory/file.txt",
+			Expected: &storageShareFileId{
+				storageAccountName: "example",
+				shareName:          "share",
+				path:               "directory",
+				fileName:           "file.txt",
+			},
+		},
+		{
+			Input: "https://example.file.core.windows.net/share/parent/child/file.txt",
+			Expected: &storageShareFileId{
+				storageAccountName: "example",
+				shareName:          "share",
+				path:               "parent/child",
+				fileName:           "file.txt",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseStorageShareFileID(v.Input, azure.PublicCloud)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", *actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestValidateArmStorageShareFileName(t *testing.T) {
+	validNames := []string{
+		"file",
+		"file.txt",
+		"with space.conf",
+		strings.Repeat("w", 255),
+	}
+	for _, v := range validNames {
+		_, errors := validateArmStorageShareFileName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid File Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"directory/file.txt",
+		"invalid|name",
+		"invalid\"name",
+		"dot.",
+		strings.Repeat("w", 256),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateArmStorageShareFileName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid File Name", v)
+		}
+	}
+}
+
+func TestStorageShareFileContentMD5Encoding(t *testing.T) {
+	// the MD5 of `hello world`
+	hexEncoded := "5eb63bbbe01eeed093cb22bb8f5acdc3"
+	base64Encoded := "XrY7u+Ae7tCTyyK7j1rNww=="
+
+	actualBase64, err := convertHexToBase64Encoding(hexEncoded)
+	if err != nil {
+		t.Fatalf("Error converting %q to base64: %+v", hexEncoded, err)
+	}
+	if actualBase64 != base64Encoded {
+		t.Fatalf("Expected %q but got %q", base64Encoded, actualBase64)
+	}
+
+	actualHex, err := convertBase64ToHexEncoding(base64Encoded)
+	if err != nil {
+		t.Fatalf("Error converting %q to hex: %+v", base64Encoded, err)
+	}
+	if actualHex != hexEncoded {
+		t.Fatalf("Expected %q but got %q", hexEncoded, actualHex)
+	}
+
+	if _, err := convertHexToBase64Encoding("not-hex"); err == nil {
+		t.Fatalf("Expected an error converting an invalid hex value but didn't get one")
+	}
+}
@@ -6,15 +6,19 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageTableCreate,
 		Read:   resourceArmStorageTableRead,
+		Update: resourceArmStorageTableUpdate,
 		Delete: resourceArmStorageTableDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -22,6 +26,13 @@ func resourceArmStorageTable() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageTableMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -35,6 +46,8 @@ func resourceArmStorageTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"acl": storageAccessControlListSchema("raud"),
 		},
 	}
 }
@@ -57,7 +70,8 @@ func validateArmStorageTableName(v interface{}, k string) (warnings []string, er
 
 func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	environment := armClient.environment
 
 	name := d.Get("name").(string)
@@ -74,6 +88,20 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 
 	table := tableClient.GetTableReference(name)
 
+	id := fmt.Sprintf("https://%s.table.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
+	if armClient.requireResourcesToBeImported && d.IsNewResource() {
+		tables, err := tableClient.QueryTables(storage.MinimalMetadata, &storage.QueryTablesOptions{})
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Table %q (Storage Account %q): %s", name, storageAccountName, err)
+		}
+
+		for _, existing := range tables.Tables {
+			if existing.Name == name {
+				return tf.ImportAsExistsError("azurerm_storage_table", id)
+			}
+		}
+	}
+
 	log.Printf("[INFO] Creating table %q in storage account %q.", name, storageAccountName)
 
 	timeout := uint(60)
@@ -83,21 +111,36 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating table %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
-	id := fmt.Sprintf("https://%s.table.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
+	if acl := d.Get("acl").(*schema.Set).List(); len(acl) > 0 {
+		aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, storageAccountName, "table", "")
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		log.Printf("[INFO] Setting ACL for table %q in storage account %q", name, storageAccountName)
+		if err := aclClient.Set(ctx, name, expandStorageAccessControlList(acl)); err != nil {
+			return fmt.Errorf("Error setting ACL for table %q in storage account %q: %+v", name, storageAccountName, err)
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageTableRead(d, meta)
 }
 
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageTableID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -144,19 +187,68 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", resourceGroup)
 
+	aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName, "table", "")
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing table %q from state", id.storageAccountName, id.tableName)
+		d.SetId("")
+		return nil
+	}
+
+	acl, err := aclClient.Get(ctx, id.tableName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL for table %q in storage account %q: %+v", id.tableName, id.storageAccountName, err)
+	}
+	if err := d.Set("acl", flattenStorageAccessControlList(acl)); err != nil {
+		return fmt.Errorf("Error setting `acl`: %+v", err)
+	}
+
 	return nil
 }
 
+func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	aclClient, accountExists, err := armClient.getSignedIdentifiersClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName, "table", "")
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	if d.HasChange("acl") {
+		log.Printf("[INFO] Updating ACL for table %q in storage account %q", id.tableName, id.storageAccountName)
+		acl := expandStorageAccessControlList(d.Get("acl").(*schema.Set).List())
+		if err := aclClient.Set(ctx, id.tableName, acl); err != nil {
+			return fmt.Errorf("Error updating ACL for table %q in storage account %q: %+v", id.tableName, id.storageAccountName, err)
+		}
+	}
+
+	return resourceArmStorageTableRead(d, meta)
+}
+
 func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageTableID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	resourceGroup, err := determineResourceGroupForStorageAccount(ctx, id.storageAccountName, armClient)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubscriptionTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Read:   resourceArmSubscriptionTemplateDeploymentRead,
+		Update: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Delete: resourceArmSubscriptionTemplateDeploymentDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseSubscriptionTemplateDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// the metadata for the deployment is stored in this location
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				StateFunc:     normalizeJson,
+				ValidateFunc:  validate.ArmTemplateBody,
+				ConflictsWith: []string{"template_link"},
+			},
+
+			"template_link": templateDeploymentLinkSchema("template_body"),
+
+			"parameters": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameters_body", "parameters_link"},
+			},
+
+			"parameters_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"parameters", "parameters_link"},
+			},
+
+			"parameters_link": templateDeploymentLinkSchema("parameters", "parameters_body"),
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceArmSubscriptionTemplateDeploymentCustomizeDiff,
+	}
+}
+
+func resourceArmSubscriptionTemplateDeploymentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := diffTemplateDeploymentTemplate(d); err != nil {
+		return err
+	}
+
+	if err := validateTemplateDeploymentParameterValues(d); err != nil {
+		return err
+	}
+
+	if !templateDeploymentRequiresValidation(d, "name", "location") {
+		return nil
+	}
+
+	client := meta.(*ArmClient).resources().subscriptionDeploymentsClient
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, templateDeploymentValidationTimeout)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
+	}
+	properties.Mode = "Incremental"
+
+	deployment := subscriptionDeployment{
+		Location:   utils.String(location),
+		Properties: properties,
+	}
+
+	result, err := client.Validate(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error validating Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if result.Error != nil {
+		return fmt.Errorf("Subscription Template Deployment %q is invalid:\n%s", name, flattenTemplateDeploymentValidationError(*result.Error))
+	}
+
+	return nil
+}
+
+func resourceArmSubscriptionTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources().subscriptionDeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Subscription Template Deployment %q: %s", name, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_subscription_template_deployment", *existing.ID)
+		}
+	}
+
+	log.Printf("[INFO] preparing arguments for AzureRM Subscription Template Deployment creation.")
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
+	}
+
+	// only Incremental deployments are supported at the Subscription scope
+	properties.Mode = "Incremental"
+
+	deployment := subscriptionDeployment{
+		Location:   utils.String(location),
+		Properties: properties,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Subscription Template Deployment %q:\n%s", name, azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Subscription Template Deployment %q to finish deploying:\n%s", name, azure.FormatError(err))
+	}
+
+	read, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", name, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subscription Template Deployment %q ID", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources().subscriptionDeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseSubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subscription Template Deployment %q was not found - removing from state", id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	d.Set("name", id.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		if err := setTemplateDeploymentProperties(d, props); err != nil {
+			return err
+		}
+	}
+
+	// the Template isn't returned from the API - so it's only exported when it's not known (e.g. when importing)
+	if d.Get("template_body").(string) == "" && len(d.Get("template_link").([]interface{})) == 0 {
+		template, err := client.ExportTemplate(ctx, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error exporting the Template for Subscription Template Deployment %q: %+v", id.Name, err)
+		}
+
+		templateBody, err := flattenTemplateBody(template.Template)
+		if err != nil {
+			return err
+		}
+		d.Set("template_body", templateBody)
+	}
+
+	return nil
+}
+
+func resourceArmSubscriptionTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources().subscriptionDeploymentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseSubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	return nil
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -20,9 +25,21 @@ func resourceArmTemplateDeployment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmTemplateDeploymentCreate,
 		Read:   resourceArmTemplateDeploymentRead,
-		Update: resourceArmTemplateDeploymentCreate,
+		Update: resourceArmTemplateDeploymentUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		Importer: azure.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ParseTemplateDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,25 +50,31 @@ func resourceArmTemplateDeployment() *schema.Resource {
 			"resource_group_name": resourceGroupNameSchema(),
 
 			"template_body": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				StateFunc: normalizeJson,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				StateFunc:     normalizeJson,
+				ValidateFunc:  validate.ArmTemplateBody,
+				ConflictsWith: []string{"template_link"},
 			},
 
+			"template_link": templateDeploymentLinkSchema("template_body"),
+
 			"parameters": {
 				Type:          schema.TypeMap,
 				Optional:      true,
-				ConflictsWith: []string{"parameters_body"},
+				ConflictsWith: []string{"parameters_body", "parameters_link"},
 			},
 
 			"parameters_body": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				StateFunc:     normalizeJson,
-				ConflictsWith: []string{"parameters"},
+				ConflictsWith: []string{"parameters", "parameters_link"},
 			},
 
+			"parameters_link": templateDeploymentLinkSchema("parameters", "parameters_body"),
+
 			"deployment_mode": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -62,73 +85,143 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"delete_resources_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"deploy_empty_template_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"outputs": {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"output_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
+
+		CustomizeDiff: resourceArmTemplateDeploymentCustomizeDiff,
 	}
 }
 
-func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+func resourceArmTemplateDeploymentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("deploy_empty_template_on_destroy").(bool) && !strings.EqualFold(d.Get("deployment_mode").(string), string(resources.Complete)) {
+		return fmt.Errorf("`deploy_empty_template_on_destroy` can only be specified when the `deployment_mode` is `Complete`")
+	}
+
+	if err := diffTemplateDeploymentTemplate(d); err != nil {
+		return err
+	}
+
+	if err := validateTemplateDeploymentParameterValues(d); err != nil {
+		return err
+	}
+
+	if !templateDeploymentRequiresValidation(d, "name", "resource_group_name", "deployment_mode") {
+		return nil
+	}
+
+	client := meta.(*ArmClient).resources().deploymentsClient
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, templateDeploymentValidationTimeout)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
-	deploymentMode := d.Get("deployment_mode").(string)
 
-	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment creation.")
-	properties := resources.DeploymentProperties{
-		Mode: resources.DeploymentMode(deploymentMode),
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
 	}
+	properties.Mode = resources.DeploymentMode(d.Get("deployment_mode").(string))
 
-	if v, ok := d.GetOk("parameters"); ok {
-		params := v.(map[string]interface{})
+	deployment := resources.Deployment{
+		Properties: properties,
+	}
 
-		newParams := make(map[string]interface{}, len(params))
-		for key, val := range params {
-			newParams[key] = struct {
-				Value interface{}
-			}{
-				Value: val,
-			}
+	result, err := client.Validate(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		// the Resource Group may not exist yet, in which case the deployment is validated when it's deployed
+		if utils.ResponseWasNotFound(result.Response) {
+			return nil
 		}
 
-		properties.Parameters = &newParams
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	if v, ok := d.GetOk("parameters_body"); ok {
-		params, err := expandParametersBody(v.(string))
-		if err != nil {
-			return err
-		}
+	if result.Error != nil {
+		return fmt.Errorf("Template Deployment %q (Resource Group %q) is invalid:\n%s", name, resourceGroup, flattenTemplateDeploymentValidationError(*result.Error))
+	}
 
-		properties.Parameters = &params
+	return nil
+}
+
+func resourceArmTemplateDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// the behaviour on destroy can be changed without re-deploying the template
+	if !d.HasChange("template_body") && !d.HasChange("template_link") && !d.HasChange("parameters") && !d.HasChange("parameters_body") && !d.HasChange("parameters_link") && !d.HasChange("deployment_mode") {
+		return resourceArmTemplateDeploymentRead(d, meta)
 	}
 
-	if v, ok := d.GetOk("template_body"); ok {
-		template, err := expandTemplateBody(v.(string))
-		if err != nil {
-			return err
-		}
+	return resourceArmTemplateDeploymentCreate(d, meta)
+}
 
-		properties.Template = &template
+func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.resources().deploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	deploymentMode := d.Get("deployment_mode").(string)
+
+	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment creation.")
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
 	}
+	properties.Mode = resources.DeploymentMode(deploymentMode)
 
 	deployment := resources.Deployment{
-		Properties: &properties,
+		Properties: properties,
+	}
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := deployClient.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Template Deployment %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_template_deployment", *existing.ID)
+		}
 	}
 
 	future, err := deployClient.CreateOrUpdate(ctx, resourceGroup, name, deployment)
 	if err != nil {
-		return fmt.Errorf("Error creating deployment: %+v", err)
+		return fmt.Errorf("Error creating deployment:\n%s", azure.FormatError(err))
 	}
 
 	err = future.WaitForCompletionRef(ctx, deployClient.Client)
 	if err != nil {
-		return fmt.Errorf("Error creating deployment: %+v", err)
+		return fmt.Errorf("Error creating deployment:\n%s", azure.FormatError(err))
 	}
 
 	read, err := deployClient.Get(ctx, resourceGroup, name)
@@ -146,18 +239,16 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.resources().deploymentsClient
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parse.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
+	name := id.Name
 
 	resp, err := deployClient.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -168,61 +259,84 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	outputs := make(map[string]string)
-	if outs := resp.Properties.Outputs; outs != nil {
-		outsVal := outs.(map[string]interface{})
-		if len(outsVal) > 0 {
-			for key, output := range outsVal {
-				log.Printf("[DEBUG] Processing deployment output %s", key)
-				outputMap := output.(map[string]interface{})
-				outputValue, ok := outputMap["value"]
-				if !ok {
-					log.Printf("[DEBUG] No value - skipping")
-					continue
-				}
-				outputType, ok := outputMap["type"]
-				if !ok {
-					log.Printf("[DEBUG] No type - skipping")
-					continue
-				}
-
-				var outputValueString string
-				switch strings.ToLower(outputType.(string)) {
-				case "bool":
-					outputValueString = strconv.FormatBool(outputValue.(bool))
-
-				case "string":
-					outputValueString = outputValue.(string)
-
-				case "int":
-					outputValueString = fmt.Sprint(outputValue)
-
-				default:
-					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurerm_template_deployment.",
-						key, outputType)
-					continue
-				}
-				outputs[key] = outputValueString
-			}
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	// these aren't returned from the API, so are set to their current values (or defaults, when importing)
+	d.Set("delete_resources_on_destroy", d.Get("delete_resources_on_destroy").(bool))
+	d.Set("deploy_empty_template_on_destroy", d.Get("deploy_empty_template_on_destroy").(bool))
+
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_mode", string(props.Mode))
+
+		if err := setTemplateDeploymentProperties(d, props); err != nil {
+			return err
 		}
 	}
 
-	return d.Set("outputs", outputs)
+	// the Template isn't returned from the API - so it's only exported when it's not known (e.g. when importing)
+	if d.Get("template_body").(string) == "" && len(d.Get("template_link").([]interface{})) == 0 {
+		template, err := deployClient.ExportTemplate(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error exporting the Template for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		templateBody, err := flattenTemplateBody(template.Template)
+		if err != nil {
+			return err
+		}
+		d.Set("template_body", templateBody)
+	}
+
+	// the resources created by the Template are only tracked when they're deleted on destroy,
+	// since this requires an additional request
+	outputResources := make([]string, 0)
+	if d.Get("delete_resources_on_destroy").(bool) {
+		outputResources, err = retrieveTemplateDeploymentOutputResources(ctx, deployClient, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Output Resources for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if err := d.Set("output_resources", outputResources); err != nil {
+		return fmt.Errorf("Error setting `output_resources`: %+v", err)
+	}
+
+	return nil
 }
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.resources().deploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parse.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
+	name := id.Name
+
+	if d.Get("delete_resources_on_destroy").(bool) {
+		// the resources are deleted in the reverse order to which they depend on one another
+		outputResources := d.Get("output_resources").([]interface{})
+		for i := len(outputResources) - 1; i >= 0; i-- {
+			resourceId := outputResources[i].(string)
+
+			log.Printf("[DEBUG] Deleting Resource %q created by Template Deployment %q (Resource Group %q)", resourceId, name, resourceGroup)
+			if err := deleteResourceByID(ctx, meta, resourceId); err != nil {
+				return fmt.Errorf("Error deleting Resource %q created by Template Deployment %q (Resource Group %q): %+v", resourceId, name, resourceGroup, err)
+			}
+		}
+	}
+
+	if d.Get("deploy_empty_template_on_destroy").(bool) {
+		// deploying an empty template in Complete mode removes any remaining resources from the Resource Group
+		log.Printf("[DEBUG] Deploying an empty Template for Template Deployment %q (Resource Group %q)", name, resourceGroup)
+		if err := deployEmptyTemplate(ctx, deployClient, resourceGroup, name); err != nil {
+			return fmt.Errorf("Error deploying an empty Template for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
 	}
 
 	_, err = deployClient.Delete(ctx, resourceGroup, name)
@@ -230,7 +344,294 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name, d.Timeout(schema.TimeoutDelete))
+}
+
+func deployEmptyTemplate(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string) error {
+	template := map[string]interface{}{
+		"$schema":        "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+		"contentVersion": "1.0.0.0",
+		"resources":      []interface{}{},
+	}
+	deployment := resources.Deployment{
+		Properties: &resources.DeploymentProperties{
+			Mode:     resources.Complete,
+			Template: &template,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		return fmt.Errorf("%s", azure.FormatError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("%s", azure.FormatError(err))
+	}
+
+	return nil
+}
+
+func templateDeploymentLinkSchema(conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.URLIsHTTPS,
+				},
+
+				"content_version": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+// templateDeploymentGetter is implemented by both a *schema.ResourceData and a *schema.ResourceDiff, so that the
+// deployment can be expanded both when it's deployed and when it's validated during the plan
+type templateDeploymentGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// expandTemplateDeploymentProperties expands the Template and Parameters for the deployment - which are shared by
+// Template Deployments within a Resource Group and at the Subscription scope
+func expandTemplateDeploymentProperties(d templateDeploymentGetter) (*resources.DeploymentProperties, error) {
+	properties := resources.DeploymentProperties{}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		params := v.(map[string]interface{})
+
+		newParams := make(map[string]interface{}, len(params))
+		for key, val := range params {
+			newParams[key] = struct {
+				Value interface{}
+			}{
+				Value: val,
+			}
+		}
+
+		properties.Parameters = &newParams
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Parameters = &params
+	}
+
+	if v, ok := d.GetOk("parameters_link"); ok {
+		uri, contentVersion := expandTemplateDeploymentLink(v.([]interface{}))
+		properties.ParametersLink = &resources.ParametersLink{
+			URI:            uri,
+			ContentVersion: contentVersion,
+		}
+	}
+
+	// `template_body` is Computed (since it's exported when importing) - so when switching to a `template_link` it can
+	// still contain the previous Template, which mustn't be sent alongside the link
+	if v, ok := d.GetOk("template_link"); ok && len(v.([]interface{})) > 0 {
+		uri, contentVersion := expandTemplateDeploymentLink(v.([]interface{}))
+		properties.TemplateLink = &resources.TemplateLink{
+			URI:            uri,
+			ContentVersion: contentVersion,
+		}
+	} else if v, ok := d.GetOk("template_body"); ok {
+		template, err := expandTemplateBody(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Template = &template
+	}
+
+	return &properties, nil
+}
+
+func expandTemplateDeploymentLink(input []interface{}) (*string, *string) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	uri := utils.String(v["uri"].(string))
+
+	var contentVersion *string
+	if version := v["content_version"].(string); version != "" {
+		contentVersion = utils.String(version)
+	}
+
+	return uri, contentVersion
+}
+
+func flattenTemplateDeploymentLink(uri *string, contentVersion *string) []interface{} {
+	if uri == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"uri": *uri,
+	}
+
+	if contentVersion != nil {
+		output["content_version"] = *contentVersion
+	}
+
+	return []interface{}{output}
+}
+
+// setTemplateDeploymentProperties sets the Links and Outputs for the deployment - which are shared by Template
+// Deployments within a Resource Group and at the Subscription scope
+func setTemplateDeploymentProperties(d *schema.ResourceData, props *resources.DeploymentPropertiesExtended) error {
+	var templateLink []interface{}
+	if link := props.TemplateLink; link != nil {
+		templateLink = flattenTemplateDeploymentLink(link.URI, link.ContentVersion)
+	}
+	if err := d.Set("template_link", templateLink); err != nil {
+		return fmt.Errorf("Error setting `template_link`: %+v", err)
+	}
+
+	// the Template is retrieved from the link - so any previous `template_body` no longer applies
+	if len(templateLink) > 0 {
+		d.Set("template_body", "")
+	}
+
+	var parametersLink []interface{}
+	if link := props.ParametersLink; link != nil {
+		parametersLink = flattenTemplateDeploymentLink(link.URI, link.ContentVersion)
+	}
+	if err := d.Set("parameters_link", parametersLink); err != nil {
+		return fmt.Errorf("Error setting `parameters_link`: %+v", err)
+	}
+
+	outputs, err := flattenTemplateDeploymentOutputs(props.Outputs)
+	if err != nil {
+		return fmt.Errorf("Error flattening `outputs`: %+v", err)
+	}
+
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("Error setting `outputs`: %+v", err)
+	}
+
+	outputsJson, err := flattenTemplateDeploymentOutputsJson(props.Outputs)
+	if err != nil {
+		return fmt.Errorf("Error flattening `outputs_json`: %+v", err)
+	}
+
+	if err := d.Set("outputs_json", outputsJson); err != nil {
+		return fmt.Errorf("Error setting `outputs_json`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenTemplateDeploymentOutputs flattens the outputs of the deployment into a map of strings - where bool, int and
+// string outputs are converted to strings and array and object outputs are JSON-encoded (use `outputs_json` to
+// access these as their original types)
+func flattenTemplateDeploymentOutputs(input interface{}) (map[string]string, error) {
+	outputs := make(map[string]string)
+
+	outsVal, ok := input.(map[string]interface{})
+	if !ok {
+		return outputs, nil
+	}
+
+	for key, output := range outsVal {
+		log.Printf("[DEBUG] Processing deployment output %s", key)
+		outputMap, ok := output.(map[string]interface{})
+		if !ok {
+			log.Printf("[DEBUG] Output isn't an object - skipping")
+			continue
+		}
+
+		outputValue, ok := outputMap["value"]
+		if !ok {
+			log.Printf("[DEBUG] No value - skipping")
+			continue
+		}
+		outputType, ok := outputMap["type"].(string)
+		if !ok {
+			log.Printf("[DEBUG] No type - skipping")
+			continue
+		}
+
+		var outputValueString string
+		switch strings.ToLower(outputType) {
+		case "bool":
+			v, ok := outputValue.(bool)
+			if !ok {
+				return nil, fmt.Errorf("Expected the value of output %q to be a bool but got %+v", key, outputValue)
+			}
+			outputValueString = strconv.FormatBool(v)
+
+		case "string", "securestring":
+			v, ok := outputValue.(string)
+			if !ok {
+				return nil, fmt.Errorf("Expected the value of output %q to be a string but got %+v", key, outputValue)
+			}
+			outputValueString = v
+
+		case "int":
+			// numbers are unmarshalled as float64's - which are formatted without an exponent
+			if v, ok := outputValue.(float64); ok {
+				outputValueString = strconv.FormatFloat(v, 'f', -1, 64)
+			} else {
+				outputValueString = fmt.Sprint(outputValue)
+			}
+
+		case "array", "object", "secureobject":
+			v, err := json.Marshal(outputValue)
+			if err != nil {
+				return nil, fmt.Errorf("Error serializing the value of output %q to JSON: %+v", key, err)
+			}
+			outputValueString = string(v)
+
+		default:
+			log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurerm_template_deployment.",
+				key, outputType)
+			continue
+		}
+		outputs[key] = outputValueString
+	}
+
+	return outputs, nil
+}
+
+// flattenTemplateDeploymentOutputsJson flattens the outputs of the deployment into a JSON object of each output's
+// name and value - retaining the type of each value, so these can be accessed using `jsondecode`
+func flattenTemplateDeploymentOutputsJson(input interface{}) (string, error) {
+	outputs := make(map[string]interface{})
+
+	if outsVal, ok := input.(map[string]interface{}); ok {
+		for key, output := range outsVal {
+			outputMap, ok := output.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if outputValue, ok := outputMap["value"]; ok {
+				outputs[key] = outputValue
+			}
+		}
+	}
+
+	b, err := json.Marshal(outputs)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
 }
 
 // TODO: move this out into the new `helpers` structure
@@ -252,6 +653,18 @@ func expandTemplateBody(template string) (map[string]interface{}, error) {
 	return templateBody, nil
 }
 
+func flattenTemplateBody(input interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("Error flattening the template_body for Azure RM Template Deployment: %+v", err)
+	}
+	return string(b), nil
+}
+
 func normalizeJson(jsonString interface{}) string {
 	if jsonString == nil || jsonString == "" {
 		return ""
@@ -265,14 +678,14 @@ func normalizeJson(jsonString interface{}) string {
 	return string(b[:])
 }
 
-func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string) error {
+func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string, timeout time.Duration) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
 	log.Printf("[DEBUG] Waiting for Template Deployment (%q in Resource Group %q) to be deleted", name, resourceGroup)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"200"},
 		Target:  []string{"404"},
 		Refresh: templateDeploymentStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: 40 * time.Minute,
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for Template Deployment (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
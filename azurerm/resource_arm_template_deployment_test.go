@@ -1,10 +1,13 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -29,6 +32,113 @@ func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTemplateDeployment_deleteResourcesOnDestroy(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	publicIpName := fmt.Sprintf("acctestpip-%d", ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_deleteResourcesOnDestroy(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists("azurerm_template_deployment.test"),
+					resource.TestCheckResourceAttr("azurerm_template_deployment.test", "output_resources.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMTemplateDeployment_resourceGroupOnly(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentOutputResourceDeleted(resourceGroup, publicIpName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_import(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMTemplateDeployment_basicSingle(ri, testLocation())
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_withTemplateLink(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMTemplateDeployment_withTemplateLink(ri, testLocation())
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_link.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_link.0.content_version", "1.0.0.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_switchTemplateBodyToTemplateLink(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_basicSingle(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_link.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMTemplateDeployment_withTemplateLink(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_body", ""),
+					resource.TestCheckResourceAttr(resourceName, "template_link.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMTemplateDeployment_disappears(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMTemplateDeployment_basicSingle(ri, testLocation())
@@ -146,7 +256,7 @@ func testCheckAzureRMTemplateDeploymentExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().deploymentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -176,7 +286,7 @@ func testCheckAzureRMTemplateDeploymentDisappears(name string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().deploymentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		_, err := client.Delete(ctx, resourceGroup, deploymentName)
@@ -184,12 +294,30 @@ func testCheckAzureRMTemplateDeploymentDisappears(name string) resource.TestChec
 			return fmt.Errorf("Failed deleting Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
 		}
 
-		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName)
+		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName, 40*time.Minute)
+	}
+}
+
+func testCheckAzureRMTemplateDeploymentOutputResourceDeleted(resourceGroup, publicIpName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).network().publicIPClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, publicIpName, "")
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return fmt.Errorf("Bad: Get on publicIPClient: %+v", err)
+		}
+
+		return fmt.Errorf("Public IP %q (Resource Group %q) created by the Template Deployment still exists", publicIpName, resourceGroup)
 	}
 }
 
 func testCheckAzureRMTemplateDeploymentDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+	client := testAccProvider.Meta().(*ArmClient).resources().deploymentsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -257,6 +385,73 @@ DEPLOY
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMTemplateDeployment_deleteResourcesOnDestroy(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                        = "acctesttemplate-%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  deployment_mode             = "Incremental"
+  delete_resources_on_destroy = true
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      }
+    }
+  ]
+}
+DEPLOY
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_resourceGroupOnly(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMTemplateDeployment_withTemplateLink(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_link {
+    uri             = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/master/101-storage-account-create/azuredeploy.json"
+    content_version = "1.0.0.0"
+  }
+
+  parameters {
+    "storageAccountType" = "Standard_LRS"
+  }
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMTemplateDeployment_basicMultiple(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -765,3 +960,220 @@ DEPLOY
 }
 `, rInt, location, rInt)
 }
+
+func TestFlattenTemplateDeploymentOutputs(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Outputs     string
+		Expected    map[string]string
+		ExpectError bool
+	}{
+		{
+			Name:     "No Outputs",
+			Outputs:  `null`,
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "Bool",
+			Outputs:  `{"enabled": {"type": "Bool", "value": true}}`,
+			Expected: map[string]string{"enabled": "true"},
+		},
+		{
+			Name:        "Bool with an invalid value",
+			Outputs:     `{"enabled": {"type": "Bool", "value": "yes"}}`,
+			ExpectError: true,
+		},
+		{
+			Name:     "Int",
+			Outputs:  `{"count": {"type": "Int", "value": 3}}`,
+			Expected: map[string]string{"count": "3"},
+		},
+		{
+			Name:     "Large Int",
+			Outputs:  `{"count": {"type": "Int", "value": 2147483648}}`,
+			Expected: map[string]string{"count": "2147483648"},
+		},
+		{
+			Name:     "String",
+			Outputs:  `{"name": {"type": "String", "value": "example"}}`,
+			Expected: map[string]string{"name": "example"},
+		},
+		{
+			Name:     "SecureString",
+			Outputs:  `{"password": {"type": "SecureString", "value": "secret"}}`,
+			Expected: map[string]string{"password": "secret"},
+		},
+		{
+			Name:     "Array",
+			Outputs:  `{"ids": {"type": "Array", "value": ["a", "b"]}}`,
+			Expected: map[string]string{"ids": `["a","b"]`},
+		},
+		{
+			Name:     "Object",
+			Outputs:  `{"config": {"type": "Object", "value": {"enabled": true, "count": 1}}}`,
+			Expected: map[string]string{"config": `{"count":1,"enabled":true}`},
+		},
+		{
+			Name:     "SecureObject",
+			Outputs:  `{"config": {"type": "SecureObject", "value": {"password": "secret"}}}`,
+			Expected: map[string]string{"config": `{"password":"secret"}`},
+		},
+		{
+			Name:     "Unsupported Type",
+			Outputs:  `{"other": {"type": "Unknown", "value": "example"}}`,
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "No Value",
+			Outputs:  `{"name": {"type": "String"}}`,
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "Multiple",
+			Outputs:  `{"name": {"type": "String", "value": "example"}, "count": {"type": "Int", "value": 2}, "enabled": {"type": "Bool", "value": false}}`,
+			Expected: map[string]string{"name": "example", "count": "2", "enabled": "false"},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var input interface{}
+		if err := json.Unmarshal([]byte(v.Outputs), &input); err != nil {
+			t.Fatalf("Error unmarshalling the Outputs: %+v", err)
+		}
+
+		actual, err := flattenTemplateDeploymentOutputs(input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenTemplateDeploymentOutputsJson(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Outputs  string
+		Expected string
+	}{
+		{
+			Name:     "No Outputs",
+			Outputs:  `null`,
+			Expected: `{}`,
+		},
+		{
+			Name:     "Scalar Types",
+			Outputs:  `{"name": {"type": "String", "value": "example"}, "count": {"type": "Int", "value": 2}, "enabled": {"type": "Bool", "value": true}}`,
+			Expected: `{"count":2,"enabled":true,"name":"example"}`,
+		},
+		{
+			Name:     "Array",
+			Outputs:  `{"ids": {"type": "Array", "value": ["a", "b"]}}`,
+			Expected: `{"ids":["a","b"]}`,
+		},
+		{
+			Name:     "Nested Object",
+			Outputs:  `{"config": {"type": "Object", "value": {"network": {"subnets": [{"name": "a"}]}}}}`,
+			Expected: `{"config":{"network":{"subnets":[{"name":"a"}]}}}`,
+		},
+		{
+			Name:     "No Value",
+			Outputs:  `{"name": {"type": "String"}}`,
+			Expected: `{}`,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var input interface{}
+		if err := json.Unmarshal([]byte(v.Outputs), &input); err != nil {
+			t.Fatalf("Error unmarshalling the Outputs: %+v", err)
+		}
+
+		actual, err := flattenTemplateDeploymentOutputsJson(input)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+type testTemplateDeploymentGetter map[string]interface{}
+
+func (g testTemplateDeploymentGetter) GetOk(key string) (interface{}, bool) {
+	v, ok := g[key]
+	return v, ok
+}
+
+func TestExpandTemplateDeploymentPropertiesTemplate(t *testing.T) {
+	templateBody := `{"$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#", "contentVersion": "1.0.0.0", "resources": []}`
+	templateLink := []interface{}{
+		map[string]interface{}{
+			"uri":             "https://example.com/azuredeploy.json",
+			"content_version": "1.0.0.0",
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		Input        testTemplateDeploymentGetter
+		ExpectedBody bool
+		ExpectedLink bool
+	}{
+		{
+			Name: "Template Body",
+			Input: testTemplateDeploymentGetter{
+				"template_body": templateBody,
+			},
+			ExpectedBody: true,
+		},
+		{
+			Name: "Template Link",
+			Input: testTemplateDeploymentGetter{
+				"template_link": templateLink,
+			},
+			ExpectedLink: true,
+		},
+		{
+			Name: "Template Link with a previous Template Body",
+			Input: testTemplateDeploymentGetter{
+				"template_body": templateBody,
+				"template_link": templateLink,
+			},
+			ExpectedLink: true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		properties, err := expandTemplateDeploymentProperties(v.Input)
+		if err != nil {
+			t.Fatalf("Error expanding the Template Deployment: %+v", err)
+		}
+
+		if hasBody := properties.Template != nil; hasBody != v.ExpectedBody {
+			t.Fatalf("Expected the Template to be set to %t but got %t", v.ExpectedBody, hasBody)
+		}
+
+		if hasLink := properties.TemplateLink != nil; hasLink != v.ExpectedLink {
+			t.Fatalf("Expected the Template Link to be set to %t but got %t", v.ExpectedLink, hasLink)
+		}
+	}
+}
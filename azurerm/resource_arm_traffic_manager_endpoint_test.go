@@ -273,7 +273,7 @@ func testCheckAzureRMTrafficManagerEndpointExists(name string) resource.TestChec
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).trafficManagerEndpointsClient
+		conn := testAccProvider.Meta().(*ArmClient).trafficManager().trafficManagerEndpointsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, profileName, path.Base(endpointType), name)
 		if err != nil {
@@ -305,7 +305,7 @@ func testCheckAzureRMTrafficManagerEndpointDisappears(name string) resource.Test
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).trafficManagerEndpointsClient
+		conn := testAccProvider.Meta().(*ArmClient).trafficManager().trafficManagerEndpointsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		_, err := conn.Delete(ctx, resourceGroup, profileName, path.Base(endpointType), name)
 		if err != nil {
@@ -317,7 +317,7 @@ func testCheckAzureRMTrafficManagerEndpointDisappears(name string) resource.Test
 }
 
 func testCheckAzureRMTrafficManagerEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).trafficManagerEndpointsClient
+	conn := testAccProvider.Meta().(*ArmClient).trafficManager().trafficManagerEndpointsClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_traffic_manager_endpoint" {
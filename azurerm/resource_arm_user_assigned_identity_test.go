@@ -40,6 +40,31 @@ func TestAccAzureRMUserAssignedIdentity_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMUserAssignedIdentity_requiresImport(t *testing.T) {
+	resourceName := "azurerm_user_assigned_identity.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(14)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMUserAssignedIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMUserAssignedIdentity_basic(ri, location, rs),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMUserAssignedIdentityExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMUserAssignedIdentity_requiresImport(ri, location, rs),
+				ExpectError: testRequiresImportError("azurerm_user_assigned_identity"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMUserAssignedIdentityExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -54,7 +79,7 @@ func testCheckAzureRMUserAssignedIdentityExists(resourceName string) resource.Te
 			return fmt.Errorf("Bad: no resource group found in state for virtual machine: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).userAssignedIdentitiesClient
+		client := testAccProvider.Meta().(*ArmClient).network().userAssignedIdentitiesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -71,7 +96,7 @@ func testCheckAzureRMUserAssignedIdentityExists(resourceName string) resource.Te
 }
 
 func testCheckAzureRMUserAssignedIdentityDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).userAssignedIdentitiesClient
+	client := testAccProvider.Meta().(*ArmClient).network().userAssignedIdentitiesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -112,3 +137,16 @@ resource "azurerm_user_assigned_identity" "test" {
 }
 `, rInt, location, rString)
 }
+
+func testAccAzureRMUserAssignedIdentity_requiresImport(rInt int, location string, rString string) string {
+	template := testAccAzureRMUserAssignedIdentity_basic(rInt, location, rString)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "import" {
+  name                = "${azurerm_user_assigned_identity.test.name}"
+  resource_group_name = "${azurerm_user_assigned_identity.test.resource_group_name}"
+  location            = "${azurerm_user_assigned_identity.test.location}"
+}
+`, template)
+}
@@ -32,7 +32,7 @@ func testSweepVirtualNetworks(region string) error {
 		return err
 	}
 
-	client := (*armClient).vnetClient
+	client := (*armClient).network().vnetClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Virtual Networks..")
@@ -105,6 +105,30 @@ func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetwork_requiresImport(t *testing.T) {
+	resourceName := "azurerm_virtual_network.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetwork_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualNetwork_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_network"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualNetwork_disappears(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
 	ri := acctest.RandInt()
@@ -199,7 +223,7 @@ func testCheckAzureRMVirtualNetworkExists(name string) resource.TestCheckFunc {
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).vnetClient
+		client := testAccProvider.Meta().(*ArmClient).network().vnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, "")
@@ -230,7 +254,7 @@ func testCheckAzureRMVirtualNetworkDisappears(name string) resource.TestCheckFun
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).vnetClient
+		client := testAccProvider.Meta().(*ArmClient).network().vnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, virtualNetworkName)
@@ -248,7 +272,7 @@ func testCheckAzureRMVirtualNetworkDisappears(name string) resource.TestCheckFun
 }
 
 func testCheckAzureRMVirtualNetworkDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).vnetClient
+	client := testAccProvider.Meta().(*ArmClient).network().vnetClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -294,6 +318,25 @@ resource "azurerm_virtual_network" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMVirtualNetwork_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "import" {
+  name                = "${azurerm_virtual_network.test.name}"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_virtual_network.test.location}"
+  resource_group_name = "${azurerm_virtual_network.test.resource_group_name}"
+
+  subnet {
+    name           = "subnet1"
+    address_prefix = "10.0.1.0/24"
+  }
+}
+`, template)
+}
+
 func testAccAzureRMVirtualNetwork_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// storageAccessControlListSchema returns the schema for the Stored Access Policies assigned to a Container, Queue,
+// Share or Table - where permissions are the characters which can be used in the `permissions` field, in the order
+// they're returned by the API
+func storageAccessControlListSchema(permissions string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		// each resource supports up to five Stored Access Policies
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+				"access_policy": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"start": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateStorageAccessPolicyTime,
+							},
+							"expiry": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateStorageAccessPolicyTime,
+							},
+							"permissions": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateStorageAccessPolicyPermissions(permissions),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateStorageAccessPolicyPermissions ensures the permissions are a subset of the supported permissions in the
+// order the API returns them (e.g. `rwdl`), since otherwise these would show a diff after each apply
+func validateStorageAccessPolicyPermissions(supported string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return warnings, errors
+		}
+
+		if v == "" {
+			errors = append(errors, fmt.Errorf("%q must not be empty", k))
+			return warnings, errors
+		}
+
+		position := 0
+		for _, permission := range v {
+			index := strings.IndexRune(supported[position:], permission)
+			if index == -1 {
+				errors = append(errors, fmt.Errorf("%q must only contain the permissions %q (each at most once, in that order) but got %q", k, supported, v))
+				return warnings, errors
+			}
+			position += index + 1
+		}
+
+		return warnings, errors
+	}
+}
+
+// storageAccessPolicyTimeFormat is the format the Start and Expiry times are stored in, which is RFC3339 in UTC
+// without fractional seconds (e.g. `2019-07-01T09:00:00Z`)
+const storageAccessPolicyTimeFormat = "2006-01-02T15:04:05Z"
+
+// validateStorageAccessPolicyTime ensures the time is in the same format it's read back in, since the times are
+// part of the hash of each `acl` - as such a time in another timezone would otherwise show a diff after each apply
+func validateStorageAccessPolicyTime(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if t, err := time.Parse(storageAccessPolicyTimeFormat, v); err != nil || t.Format(storageAccessPolicyTimeFormat) != v {
+		errors = append(errors, fmt.Errorf("%q must be an RFC3339 time in UTC without fractional seconds (such as `2019-07-01T09:00:00Z`) but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+func expandStorageAccessControlList(input []interface{}) []storageSignedIdentifier {
+	identifiers := make([]storageSignedIdentifier, 0)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		identifier := storageSignedIdentifier{
+			Id: v["id"].(string),
+		}
+
+		if policies := v["access_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+			policy := policies[0].(map[string]interface{})
+			identifier.AccessPolicy = storageAccessPolicy{
+				Start:      policy["start"].(string),
+				Expiry:     policy["expiry"].(string),
+				Permission: policy["permissions"].(string),
+			}
+		}
+
+		identifiers = append(identifiers, identifier)
+	}
+
+	return identifiers
+}
+
+func flattenStorageAccessControlList(input []storageSignedIdentifier) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, identifier := range input {
+		policy := map[string]interface{}{
+			"start":       formatStorageAccessPolicyTime(identifier.AccessPolicy.Start),
+			"expiry":      formatStorageAccessPolicyTime(identifier.AccessPolicy.Expiry),
+			"permissions": identifier.AccessPolicy.Permission,
+		}
+
+		output = append(output, map[string]interface{}{
+			"id":            identifier.Id,
+			"access_policy": []interface{}{policy},
+		})
+	}
+
+	return output
+}
+
+// formatStorageAccessPolicyTime normalises a time returned by the API (e.g. `2019-07-01T09:38:05.0000000Z`) into the
+// format used in the configuration - values which can't be parsed are returned as-is
+func formatStorageAccessPolicyTime(input string) string {
+	t, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return input
+	}
+
+	return t.UTC().Format(storageAccessPolicyTimeFormat)
+}
@@ -0,0 +1,158 @@
+package azurerm
+
+import (
+	"testing"
+)
+
+func TestValidateStorageAccessPolicyPermissions(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "r",
+			Errors: 0,
+		},
+		{
+			Value:  "rwdl",
+			Errors: 0,
+		},
+		{
+			Value:  "racwdl",
+			Errors: 0,
+		},
+		{
+			Value:  "wr",
+			Errors: 1,
+		},
+		{
+			Value:  "rr",
+			Errors: 1,
+		},
+		{
+			Value:  "rx",
+			Errors: 1,
+		},
+		{
+			Value:  "R",
+			Errors: 1,
+		},
+	}
+
+	validateFunc := validateStorageAccessPolicyPermissions("racwdl")
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Value)
+
+		_, errors := validateFunc(tc.Value, "permissions")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected %d errors for %q but got %d: %+v", tc.Errors, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateStorageAccessPolicyTime(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "2019-07-01T09:00:00Z",
+			Errors: 0,
+		},
+		{
+			Value:  "2019-07-01T10:00:00+01:00",
+			Errors: 1,
+		},
+		{
+			Value:  "2019-07-01T09:00:00.5Z",
+			Errors: 1,
+		},
+		{
+			Value:  "2019-07-01",
+			Errors: 1,
+		},
+		{
+			Value:  "",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Value)
+
+		_, errors := validateStorageAccessPolicyTime(tc.Value, "start")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected %d errors for %q but got %d: %+v", tc.Errors, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestExpandStorageAccessControlList(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"id": "policy1",
+			"access_policy": []interface{}{
+				map[string]interface{}{
+					"start":       "2019-07-01T09:00:00Z",
+					"expiry":      "2019-07-02T09:00:00Z",
+					"permissions": "rwdl",
+				},
+			},
+		},
+	}
+
+	actual := expandStorageAccessControlList(input)
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 Signed Identifier but got %d", len(actual))
+	}
+
+	expected := storageSignedIdentifier{
+		Id: "policy1",
+		AccessPolicy: storageAccessPolicy{
+			Start:      "2019-07-01T09:00:00Z",
+			Expiry:     "2019-07-02T09:00:00Z",
+			Permission: "rwdl",
+		},
+	}
+	if actual[0] != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual[0])
+	}
+}
+
+func TestFlattenStorageAccessControlList(t *testing.T) {
+	input := []storageSignedIdentifier{
+		{
+			Id: "policy1",
+			AccessPolicy: storageAccessPolicy{
+				Start:      "2019-07-01T09:38:05.0000000Z",
+				Expiry:     "2019-07-02T09:38:05.0000000Z",
+				Permission: "raup",
+			},
+		},
+	}
+
+	actual := flattenStorageAccessControlList(input)
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 ACL but got %d", len(actual))
+	}
+
+	acl := actual[0].(map[string]interface{})
+	if acl["id"] != "policy1" {
+		t.Fatalf("Expected the ID to be %q but got %q", "policy1", acl["id"])
+	}
+
+	policy := acl["access_policy"].([]interface{})[0].(map[string]interface{})
+	if policy["start"] != "2019-07-01T09:38:05Z" {
+		t.Fatalf("Expected the Start to be %q but got %q", "2019-07-01T09:38:05Z", policy["start"])
+	}
+	if policy["expiry"] != "2019-07-02T09:38:05Z" {
+		t.Fatalf("Expected the Expiry to be %q but got %q", "2019-07-02T09:38:05Z", policy["expiry"])
+	}
+	if policy["permissions"] != "raup" {
+		t.Fatalf("Expected the Permissions to be %q but got %q", "raup", policy["permissions"])
+	}
+}
@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// storageAccountServicesApiVersion is the API version used to manage the Blob Service Properties and to retrieve the
+// Static Website endpoints of a Storage Account, which aren't supported by the API version in the Storage SDK - as
+// such these requests are made directly
+const storageAccountServicesApiVersion = "2019-06-01"
+
+// storageAccountServicesClient manages the Blob Service Properties of a Storage Account
+type storageAccountServicesClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+type storageBlobServiceProperties struct {
+	autorest.Response `json:"-"`
+	Properties        *storageBlobServicePropertiesProperties `json:"properties,omitempty"`
+}
+
+type storageBlobServicePropertiesProperties struct {
+	Cors                  *storageCorsRules             `json:"cors,omitempty"`
+	DeleteRetentionPolicy *storageDeleteRetentionPolicy `json:"deleteRetentionPolicy,omitempty"`
+	IsVersioningEnabled   *bool                         `json:"isVersioningEnabled,omitempty"`
+}
+
+type storageCorsRules struct {
+	// all of the CORS Rules are replaced, as such this isn't omitted when empty
+	CorsRules *[]storageCorsRule `json:"corsRules"`
+}
+
+type storageCorsRule struct {
+	AllowedOrigins  *[]string `json:"allowedOrigins,omitempty"`
+	AllowedMethods  *[]string `json:"allowedMethods,omitempty"`
+	MaxAgeInSeconds *int32    `json:"maxAgeInSeconds,omitempty"`
+	ExposedHeaders  *[]string `json:"exposedHeaders,omitempty"`
+	AllowedHeaders  *[]string `json:"allowedHeaders,omitempty"`
+}
+
+type storageDeleteRetentionPolicy struct {
+	Enabled *bool  `json:"enabled,omitempty"`
+	Days    *int32 `json:"days,omitempty"`
+}
+
+type storageAccountWithWebEndpoints struct {
+	autorest.Response `json:"-"`
+	Properties        *storageAccountWebEndpointsProperties `json:"properties,omitempty"`
+}
+
+type storageAccountWebEndpointsProperties struct {
+	PrimaryEndpoints   *storageWebEndpoint `json:"primaryEndpoints,omitempty"`
+	SecondaryEndpoints *storageWebEndpoint `json:"secondaryEndpoints,omitempty"`
+}
+
+type storageWebEndpoint struct {
+	Web *string `json:"web,omitempty"`
+}
+
+func newStorageAccountServicesClientWithBaseURI(baseURI string, subscriptionID string) storageAccountServicesClient {
+	return storageAccountServicesClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// GetBlobServiceProperties retrieves the Blob Service Properties (CORS, Delete Retention and Versioning)
+func (client storageAccountServicesClient) GetBlobServiceProperties(ctx context.Context, resourceGroupName, accountName string) (result storageBlobServiceProperties, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, accountName, "/blobServices/default", autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "GetBlobServiceProperties", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "GetBlobServiceProperties", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "GetBlobServiceProperties", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// SetBlobServiceProperties sets the Blob Service Properties - where any properties which aren't specified are unchanged
+func (client storageAccountServicesClient) SetBlobServiceProperties(ctx context.Context, resourceGroupName, accountName string, parameters storageBlobServiceProperties) error {
+	req, err := client.prepare(ctx, resourceGroupName, accountName, "/blobServices/default",
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "SetBlobServiceProperties", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "SetBlobServiceProperties", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "SetBlobServiceProperties", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// GetWebEndpoints retrieves the Static Website endpoints of the Storage Account
+func (client storageAccountServicesClient) GetWebEndpoints(ctx context.Context, resourceGroupName, accountName string) (result storageAccountWithWebEndpoints, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, accountName, "", autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "GetWebEndpoints", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "GetWebEndpoints", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageAccountServicesClient", "GetWebEndpoints", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (client storageAccountServicesClient) prepare(ctx context.Context, resourceGroupName, accountName string, suffix string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"accountName":       autorest.Encode("path", accountName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": storageAccountServicesApiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}"+suffix, pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
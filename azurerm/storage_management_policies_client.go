@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// storageManagementPoliciesApiVersion is the API version used to manage the Lifecycle Management Policy of a Storage
+// Account, which isn't supported by the API version in the Storage SDK - as such these requests are made directly
+const storageManagementPoliciesApiVersion = "2019-06-01"
+
+// storageManagementPolicyName is the name of the Management Policy, since only a single one exists per Storage Account
+const storageManagementPolicyName = "default"
+
+// storageManagementPoliciesClient manages the Lifecycle Management Policy of a Storage Account
+type storageManagementPoliciesClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+type storageManagementPolicy struct {
+	autorest.Response `json:"-"`
+	ID                *string                            `json:"id,omitempty"`
+	Name              *string                            `json:"name,omitempty"`
+	Properties        *storageManagementPolicyProperties `json:"properties,omitempty"`
+}
+
+type storageManagementPolicyProperties struct {
+	Policy *storageManagementPolicySchema `json:"policy,omitempty"`
+}
+
+type storageManagementPolicySchema struct {
+	// all of the Rules are replaced, as such this isn't omitted when empty
+	Rules *[]storageManagementPolicyRule `json:"rules"`
+}
+
+type storageManagementPolicyRule struct {
+	Enabled    *bool                              `json:"enabled,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+	Definition *storageManagementPolicyDefinition `json:"definition,omitempty"`
+}
+
+type storageManagementPolicyDefinition struct {
+	Actions *storageManagementPolicyActions `json:"actions,omitempty"`
+	Filters *storageManagementPolicyFilters `json:"filters,omitempty"`
+}
+
+type storageManagementPolicyActions struct {
+	BaseBlob *storageManagementPolicyBaseBlob `json:"baseBlob,omitempty"`
+	Snapshot *storageManagementPolicySnapshot `json:"snapshot,omitempty"`
+}
+
+type storageManagementPolicyBaseBlob struct {
+	TierToCool    *storageManagementPolicyDaysAfterModification `json:"tierToCool,omitempty"`
+	TierToArchive *storageManagementPolicyDaysAfterModification `json:"tierToArchive,omitempty"`
+	Delete        *storageManagementPolicyDaysAfterModification `json:"delete,omitempty"`
+}
+
+type storageManagementPolicySnapshot struct {
+	Delete *storageManagementPolicyDaysAfterCreation `json:"delete,omitempty"`
+}
+
+type storageManagementPolicyDaysAfterModification struct {
+	DaysAfterModificationGreaterThan *int32 `json:"daysAfterModificationGreaterThan,omitempty"`
+}
+
+type storageManagementPolicyDaysAfterCreation struct {
+	DaysAfterCreationGreaterThan *int32 `json:"daysAfterCreationGreaterThan,omitempty"`
+}
+
+type storageManagementPolicyFilters struct {
+	PrefixMatch *[]string `json:"prefixMatch,omitempty"`
+	BlobTypes   *[]string `json:"blobTypes,omitempty"`
+}
+
+func newStorageManagementPoliciesClientWithBaseURI(baseURI string, subscriptionID string) storageManagementPoliciesClient {
+	return storageManagementPoliciesClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// CreateOrUpdate sets the Management Policy for the Storage Account, replacing any existing Rules
+func (client storageManagementPoliciesClient) CreateOrUpdate(ctx context.Context, resourceGroupName, accountName string, parameters storageManagementPolicy) (result storageManagementPolicy, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, accountName,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Get retrieves the Management Policy for the Storage Account - a 404 is returned when one doesn't exist
+func (client storageManagementPoliciesClient) Get(ctx context.Context, resourceGroupName, accountName string) (result storageManagementPolicy, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, accountName, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Delete removes the Management Policy from the Storage Account
+func (client storageManagementPoliciesClient) Delete(ctx context.Context, resourceGroupName, accountName string) (result autorest.Response, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, accountName, autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = resp
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageManagementPoliciesClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (client storageManagementPoliciesClient) prepare(ctx context.Context, resourceGroupName, accountName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"accountName":          autorest.Encode("path", accountName),
+		"managementPolicyName": autorest.Encode("path", storageManagementPolicyName),
+		"resourceGroupName":    autorest.Encode("path", resourceGroupName),
+		"subscriptionId":       autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": storageManagementPoliciesApiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}/managementPolicies/{managementPolicyName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
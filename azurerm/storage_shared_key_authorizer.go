@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
+)
+
+// storageSharedKeyAuthorizer signs requests to the Storage data plane using the Storage Account's Access Key, for
+// requests which aren't supported by the Storage SDK - see https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+type storageSharedKeyAuthorizer struct {
+	accountName string
+	accountKey  []byte
+
+	// table specifies whether requests are signed using the (shorter) format used by the Table Service
+	table bool
+}
+
+func newStorageSharedKeyAuthorizer(accountName, accountKey string) (*storageSharedKeyAuthorizer, error) {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding the Access Key for Storage Account %q: %+v", accountName, err)
+	}
+
+	return &storageSharedKeyAuthorizer{
+		accountName: accountName,
+		accountKey:  key,
+	}, nil
+}
+
+// newStorageTableSharedKeyAuthorizer returns an Authorizer which signs requests to the Table Service
+func newStorageTableSharedKeyAuthorizer(accountName, accountKey string) (*storageSharedKeyAuthorizer, error) {
+	authorizer, err := newStorageSharedKeyAuthorizer(accountName, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizer.table = true
+	return authorizer, nil
+}
+
+func (a *storageSharedKeyAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Header.Get("x-ms-date") == "" {
+				r.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+			}
+
+			// the signature covers every `x-ms-` header, so the Client Request ID for the current Operation is
+			// set here - since this can't be added by the Sender once the request has been signed
+			if op := correlation.FromContext(r.Context()); op != nil && r.Header.Get(correlation.ClientRequestIDHeader) == "" {
+				r.Header.Set(correlation.ClientRequestIDHeader, op.ClientRequestID)
+			}
+
+			signature := a.sign(a.stringToSign(r))
+			r.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", a.accountName, signature))
+			return r, nil
+		})
+	}
+}
+
+func (a *storageSharedKeyAuthorizer) stringToSign(r *http.Request) string {
+	if a.table {
+		return strings.Join([]string{
+			r.Method,
+			r.Header.Get("Content-MD5"),
+			r.Header.Get("Content-Type"),
+			r.Header.Get("x-ms-date"),
+			a.tableCanonicalizedResource(r.URL),
+		}, "\n")
+	}
+
+	contentLength := ""
+	if r.ContentLength > 0 {
+		contentLength = strconv.FormatInt(r.ContentLength, 10)
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		r.Header.Get("Content-Encoding"),
+		r.Header.Get("Content-Language"),
+		contentLength,
+		r.Header.Get("Content-MD5"),
+		r.Header.Get("Content-Type"),
+		// the Date is empty since the `x-ms-date` header is used
+		"",
+		r.Header.Get("If-Modified-Since"),
+		r.Header.Get("If-Match"),
+		r.Header.Get("If-None-Match"),
+		r.Header.Get("If-Unmodified-Since"),
+		r.Header.Get("Range"),
+		storageCanonicalizedHeaders(r.Header),
+		a.canonicalizedResource(r.URL),
+	}, "\n")
+}
+
+func (a *storageSharedKeyAuthorizer) canonicalizedResource(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	resource := fmt.Sprintf("/%s%s", a.accountName, path)
+
+	query := u.Query()
+	keys := make([]string, 0)
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		resource += fmt.Sprintf("\n%s:%s", strings.ToLower(key), strings.Join(values, ","))
+	}
+
+	return resource
+}
+
+// tableCanonicalizedResource returns the Canonicalized Resource used by the Table Service, which only includes
+// the `comp` query parameter
+func (a *storageSharedKeyAuthorizer) tableCanonicalizedResource(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	resource := fmt.Sprintf("/%s%s", a.accountName, path)
+	if comp := u.Query().Get("comp"); comp != "" {
+		resource += fmt.Sprintf("?comp=%s", comp)
+	}
+
+	return resource
+}
+
+func (a *storageSharedKeyAuthorizer) sign(input string) string {
+	h := hmac.New(sha256.New, a.accountKey)
+	h.Write([]byte(input))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// storageCanonicalizedHeaders returns the `x-ms-` headers in the format used in the signature
+func storageCanonicalizedHeaders(headers http.Header) string {
+	values := make(map[string]string)
+	for key, value := range headers {
+		name := strings.ToLower(strings.TrimSpace(key))
+		if strings.HasPrefix(name, "x-ms-") {
+			values[name] = strings.TrimSpace(strings.Join(value, ","))
+		}
+	}
+
+	names := make([]string, 0)
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0)
+	for _, name := range names {
+		lines = append(lines, fmt.Sprintf("%s:%s", name, values[name]))
+	}
+
+	return strings.Join(lines, "\n")
+}
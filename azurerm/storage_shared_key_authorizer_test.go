@@ -0,0 +1,120 @@
+package azurerm
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestStorageSharedKeyAuthorizer(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Method        string
+		Url           string
+		Body          string
+		ContentType   string
+		Authorization string
+	}{
+		{
+			Name:          "Service Properties",
+			Method:        http.MethodPut,
+			Url:           "https://example.blob.core.windows.net/?restype=service&comp=properties",
+			Body:          strings.Repeat("a", 123),
+			ContentType:   "application/xml",
+			Authorization: "SharedKey example:dN91uuBN4JGmQQUHA474z4WomPZ0CRMxMT2a+Aw+UJ0=",
+		},
+		{
+			Name:          "Escaped Path",
+			Method:        http.MethodGet,
+			Url:           "https://example.blob.core.windows.net/container/path%20with%20space?comp=acl&restype=container",
+			Authorization: "SharedKey example:FbNSpwpNWzEzNSmzMVxvMEzHlABCUFCFZfh1UpWtRgE=",
+		},
+	}
+
+	authorizer, err := newStorageSharedKeyAuthorizer("example", "YXp1cmVhZA==")
+	if err != nil {
+		t.Fatalf("Error building the Authorizer: %+v", err)
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		decorators := []autorest.PrepareDecorator{
+			autorest.WithMethod(v.Method),
+			autorest.WithBaseURL(v.Url),
+			autorest.WithHeader("x-ms-date", "Mon, 02 Jan 2006 15:04:05 GMT"),
+			autorest.WithHeader("x-ms-version", "2019-02-02"),
+		}
+		if v.Body != "" {
+			decorators = append(decorators, autorest.AsContentType(v.ContentType), autorest.WithString(v.Body))
+		}
+		decorators = append(decorators, authorizer.WithAuthorization())
+
+		req, err := autorest.Prepare(&http.Request{}, decorators...)
+		if err != nil {
+			t.Fatalf("Error preparing the request: %+v", err)
+		}
+
+		if actual := req.Header.Get("Authorization"); actual != v.Authorization {
+			t.Fatalf("Expected the Authorization header to be %q but got %q", v.Authorization, actual)
+		}
+	}
+}
+
+func TestStorageSharedKeyAuthorizerInvalidKey(t *testing.T) {
+	if _, err := newStorageSharedKeyAuthorizer("example", "not base64!"); err == nil {
+		t.Fatalf("Expected an error for an Access Key which isn't base64-encoded")
+	}
+}
+
+func TestStorageTableSharedKeyAuthorizer(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Method        string
+		Body          string
+		Authorization string
+	}{
+		{
+			Name:          "Get Table ACL",
+			Method:        http.MethodGet,
+			Authorization: "SharedKey example:N1Gcdql1Hql6J0N852bYD7sPxkogSxTync/XZSsdgbU=",
+		},
+		{
+			Name:          "Set Table ACL",
+			Method:        http.MethodPut,
+			Body:          "<SignedIdentifiers></SignedIdentifiers>",
+			Authorization: "SharedKey example:5x0BJHo7/jUUuzp4R+MehHiqQNN8KQmzIdx7jLq3+3o=",
+		},
+	}
+
+	authorizer, err := newStorageTableSharedKeyAuthorizer("example", "YXp1cmVhZA==")
+	if err != nil {
+		t.Fatalf("Error building the Authorizer: %+v", err)
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		decorators := []autorest.PrepareDecorator{
+			autorest.WithMethod(v.Method),
+			autorest.WithBaseURL("https://example.table.core.windows.net/mytable?comp=acl"),
+			autorest.WithHeader("x-ms-date", "Mon, 02 Jan 2006 15:04:05 GMT"),
+			autorest.WithHeader("x-ms-version", "2019-02-02"),
+		}
+		if v.Body != "" {
+			decorators = append(decorators, autorest.AsContentType("application/xml"), autorest.WithString(v.Body))
+		}
+		decorators = append(decorators, authorizer.WithAuthorization())
+
+		req, err := autorest.Prepare(&http.Request{}, decorators...)
+		if err != nil {
+			t.Fatalf("Error preparing the request: %+v", err)
+		}
+
+		if actual := req.Header.Get("Authorization"); actual != v.Authorization {
+			t.Fatalf("Expected the Authorization header to be %q but got %q", v.Authorization, actual)
+		}
+	}
+}
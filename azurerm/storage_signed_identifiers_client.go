@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// storageSignedIdentifiersApiVersion is the API version used to manage the Stored Access Policies on Containers,
+// Queues, Shares and Tables - which the Storage SDK either doesn't support or only partially supports (e.g. the
+// `list` permission on Containers) - as such these requests are made directly
+const storageSignedIdentifiersApiVersion = "2019-02-02"
+
+// storageSignedIdentifiersClient manages the Stored Access Policies (known as Signed Identifiers in the API)
+// assigned to a Container, Queue, Share or Table
+type storageSignedIdentifiersClient struct {
+	autorest.Client
+	BaseURI string
+
+	// ResourceType is the `restype` of the resource the Signed Identifiers are assigned to, which is empty for
+	// Queues and Tables
+	ResourceType string
+}
+
+type storageSignedIdentifiers struct {
+	XMLName           xml.Name                  `xml:"SignedIdentifiers"`
+	SignedIdentifiers []storageSignedIdentifier `xml:"SignedIdentifier"`
+}
+
+type storageSignedIdentifier struct {
+	Id           string              `xml:"Id"`
+	AccessPolicy storageAccessPolicy `xml:"AccessPolicy"`
+}
+
+type storageAccessPolicy struct {
+	Start      string `xml:"Start"`
+	Expiry     string `xml:"Expiry"`
+	Permission string `xml:"Permission"`
+}
+
+func newStorageSignedIdentifiersClient(baseURI string, resourceType string) storageSignedIdentifiersClient {
+	return storageSignedIdentifiersClient{
+		Client:       autorest.NewClientWithUserAgent(""),
+		BaseURI:      baseURI,
+		ResourceType: resourceType,
+	}
+}
+
+// Get retrieves the Signed Identifiers assigned to the specified resource
+func (client storageSignedIdentifiersClient) Get(ctx context.Context, name string) (result []storageSignedIdentifier, err error) {
+	req, err := client.prepare(ctx, name, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageSignedIdentifiersClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageSignedIdentifiersClient", "Get", resp, "Failure sending request")
+	}
+
+	var identifiers storageSignedIdentifiers
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&identifiers),
+		autorest.ByClosing())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageSignedIdentifiersClient", "Get", resp, "Failure responding to request")
+	}
+
+	return identifiers.SignedIdentifiers, nil
+}
+
+// Set replaces the Signed Identifiers assigned to the specified resource - any additional decorators (such as the
+// `x-ms-blob-public-access` header for Containers) are applied to the request
+func (client storageSignedIdentifiersClient) Set(ctx context.Context, name string, identifiers []storageSignedIdentifier, decorators ...autorest.PrepareDecorator) error {
+	body, err := xml.Marshal(storageSignedIdentifiers{
+		SignedIdentifiers: identifiers,
+	})
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageSignedIdentifiersClient", "Set", nil, "Failure marshalling request")
+	}
+
+	decorators = append(decorators,
+		autorest.AsContentType("application/xml; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithString(xml.Header+string(body)))
+	req, err := client.prepare(ctx, name, decorators...)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageSignedIdentifiersClient", "Set", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageSignedIdentifiersClient", "Set", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageSignedIdentifiersClient", "Set", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func (client storageSignedIdentifiersClient) prepare(ctx context.Context, name string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	queryParameters := map[string]interface{}{
+		"comp": "acl",
+	}
+	if client.ResourceType != "" {
+		queryParameters["restype"] = client.ResourceType
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-version", storageSignedIdentifiersApiVersion))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
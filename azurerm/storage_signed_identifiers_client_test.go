@@ -0,0 +1,151 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
+)
+
+func TestStorageSignedIdentifiersXML(t *testing.T) {
+	body, err := xml.Marshal(storageSignedIdentifiers{
+		SignedIdentifiers: []storageSignedIdentifier{
+			{
+				Id: "policy1",
+				AccessPolicy: storageAccessPolicy{
+					Start:      "2019-07-01T09:38:05Z",
+					Expiry:     "2019-07-02T09:38:05Z",
+					Permission: "rwdl",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error marshalling: %+v", err)
+	}
+
+	expected := "<SignedIdentifiers><SignedIdentifier><Id>policy1</Id><AccessPolicy><Start>2019-07-01T09:38:05Z</Start><Expiry>2019-07-02T09:38:05Z</Expiry><Permission>rwdl</Permission></AccessPolicy></SignedIdentifier></SignedIdentifiers>"
+	if string(body) != expected {
+		t.Fatalf("Expected %q but got %q", expected, string(body))
+	}
+
+	// removing all of the Signed Identifiers sends an empty list
+	body, err = xml.Marshal(storageSignedIdentifiers{})
+	if err != nil {
+		t.Fatalf("Error marshalling: %+v", err)
+	}
+	if expected := "<SignedIdentifiers></SignedIdentifiers>"; string(body) != expected {
+		t.Fatalf("Expected %q but got %q", expected, string(body))
+	}
+
+	response := `<?xml version="1.0" encoding="utf-8"?><SignedIdentifiers><SignedIdentifier><Id>policy1</Id><AccessPolicy><Start>2019-07-01T09:38:05.0000000Z</Start><Expiry>2019-07-02T09:38:05.0000000Z</Expiry><Permission>rwdl</Permission></AccessPolicy></SignedIdentifier></SignedIdentifiers>`
+	var identifiers storageSignedIdentifiers
+	if err := xml.Unmarshal([]byte(response), &identifiers); err != nil {
+		t.Fatalf("Error unmarshalling: %+v", err)
+	}
+
+	if len(identifiers.SignedIdentifiers) != 1 || identifiers.SignedIdentifiers[0].Id != "policy1" || identifiers.SignedIdentifiers[0].AccessPolicy.Permission != "rwdl" {
+		t.Fatalf("Expected a single Signed Identifier named %q but got %+v", "policy1", identifiers.SignedIdentifiers)
+	}
+}
+
+func TestStorageSignedIdentifiersClientURL(t *testing.T) {
+	cases := []struct {
+		ResourceType string
+		Expected     string
+	}{
+		{
+			ResourceType: "container",
+			Expected:     "https://example.blob.core.windows.net/container1?comp=acl&restype=container",
+		},
+		{
+			ResourceType: "",
+			Expected:     "https://example.blob.core.windows.net/container1?comp=acl",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.ResourceType)
+
+		client := newStorageSignedIdentifiersClient("https://example.blob.core.windows.net", v.ResourceType)
+		req, err := client.prepare(context.Background(), "container1", autorest.AsGet())
+		if err != nil {
+			t.Fatalf("Error preparing the request: %+v", err)
+		}
+
+		if req.Method != http.MethodGet {
+			t.Fatalf("Expected the method to be %q but got %q", http.MethodGet, req.Method)
+		}
+		if actual := req.URL.String(); actual != v.Expected {
+			t.Fatalf("Expected the URL to be %q but got %q", v.Expected, actual)
+		}
+		if actual := req.Header.Get("x-ms-version"); actual != storageSignedIdentifiersApiVersion {
+			t.Fatalf("Expected the API version to be %q but got %q", storageSignedIdentifiersApiVersion, actual)
+		}
+	}
+}
+
+func TestStorageSignedIdentifiersClientSharedKeySignature(t *testing.T) {
+	blobAuthorizer, err := newStorageSharedKeyAuthorizer("example", "YXp1cmVhZA==")
+	if err != nil {
+		t.Fatalf("Error building the Authorizer: %+v", err)
+	}
+	tableAuthorizer, err := newStorageTableSharedKeyAuthorizer("example", "YXp1cmVhZA==")
+	if err != nil {
+		t.Fatalf("Error building the Table Authorizer: %+v", err)
+	}
+
+	cases := []struct {
+		Name         string
+		BaseURI      string
+		ResourceType string
+		Authorizer   *storageSharedKeyAuthorizer
+	}{
+		{
+			Name:         "Container",
+			BaseURI:      "https://example.blob.core.windows.net",
+			ResourceType: "container",
+			Authorizer:   blobAuthorizer,
+		},
+		{
+			Name:       "Table",
+			BaseURI:    "https://example.table.core.windows.net",
+			Authorizer: tableAuthorizer,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		requests := make([]*http.Request, 0)
+		client := newStorageSignedIdentifiersClient(v.BaseURI, v.ResourceType)
+		client.Authorizer = v.Authorizer
+		client.Sender = azure.DecorateSender(testStorageSignatureVerifier(t, v.Authorizer, http.StatusOK, &requests), azure.DefaultRetryPolicy(), nil)
+
+		op := correlation.NewOperation()
+		identifiers := []storageSignedIdentifier{
+			{
+				Id: "policy1",
+				AccessPolicy: storageAccessPolicy{
+					Start:      "2019-07-01T09:00:00Z",
+					Expiry:     "2019-07-02T09:00:00Z",
+					Permission: "r",
+				},
+			},
+		}
+		if err := client.Set(correlation.NewContext(context.Background(), op), "example", identifiers); err != nil {
+			t.Fatalf("Error setting the Signed Identifiers: %+v", err)
+		}
+
+		if len(requests) != 1 {
+			t.Fatalf("Expected a single request but got %d", len(requests))
+		}
+		if actual := requests[0].Header.Get(correlation.ClientRequestIDHeader); actual != op.ClientRequestID {
+			t.Fatalf("Expected the Client Request ID to be %q but got %q", op.ClientRequestID, actual)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// storageStaticWebsiteApiVersion is the API version of the Blob Service used to manage the Static Website, which
+// isn't supported by the Storage SDK - as such these requests are made directly
+const storageStaticWebsiteApiVersion = "2019-02-02"
+
+// storageStaticWebsiteClient manages the Static Website hosted from the `$web` container of a Storage Account
+type storageStaticWebsiteClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+type storageStaticWebsiteServiceProperties struct {
+	XMLName       xml.Name              `xml:"StorageServiceProperties"`
+	StaticWebsite *storageStaticWebsite `xml:"StaticWebsite,omitempty"`
+}
+
+type storageStaticWebsite struct {
+	Enabled              bool   `xml:"Enabled"`
+	IndexDocument        string `xml:"IndexDocument,omitempty"`
+	ErrorDocument404Path string `xml:"ErrorDocument404Path,omitempty"`
+}
+
+func newStorageStaticWebsiteClient(baseURI string) storageStaticWebsiteClient {
+	return storageStaticWebsiteClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseURI,
+	}
+}
+
+// Get retrieves the Static Website configuration from the Blob Service Properties
+func (client storageStaticWebsiteClient) Get(ctx context.Context) (result storageStaticWebsite, err error) {
+	req, err := client.prepare(ctx, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageStaticWebsiteClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageStaticWebsiteClient", "Get", resp, "Failure sending request")
+	}
+
+	var properties storageStaticWebsiteServiceProperties
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&properties),
+		autorest.ByClosing())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.storageStaticWebsiteClient", "Get", resp, "Failure responding to request")
+	}
+
+	if properties.StaticWebsite != nil {
+		result = *properties.StaticWebsite
+	}
+
+	return result, nil
+}
+
+// Set sets the Static Website configuration - the other Blob Service Properties (which are omitted) are unchanged
+func (client storageStaticWebsiteClient) Set(ctx context.Context, website storageStaticWebsite) error {
+	body, err := xml.Marshal(storageStaticWebsiteServiceProperties{
+		StaticWebsite: &website,
+	})
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageStaticWebsiteClient", "Set", nil, "Failure marshalling request")
+	}
+
+	req, err := client.prepare(ctx,
+		autorest.AsContentType("application/xml; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithString(xml.Header+string(body)))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageStaticWebsiteClient", "Set", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageStaticWebsiteClient", "Set", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azurerm.storageStaticWebsiteClient", "Set", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func (client storageStaticWebsiteClient) prepare(ctx context.Context, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"comp":    "properties",
+		"restype": "service",
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath("/"),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-version", storageStaticWebsiteApiVersion))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
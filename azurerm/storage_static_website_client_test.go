@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/correlation"
+)
+
+func TestStorageStaticWebsiteServicePropertiesXML(t *testing.T) {
+	body, err := xml.Marshal(storageStaticWebsiteServiceProperties{
+		StaticWebsite: &storageStaticWebsite{
+			Enabled:              true,
+			IndexDocument:        "index.html",
+			ErrorDocument404Path: "404.html",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error marshalling: %+v", err)
+	}
+
+	expected := "<StorageServiceProperties><StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument><ErrorDocument404Path>404.html</ErrorDocument404Path></StaticWebsite></StorageServiceProperties>"
+	if string(body) != expected {
+		t.Fatalf("Expected %q but got %q", expected, string(body))
+	}
+
+	// the other Blob Service Properties are ignored
+	response := `<?xml version="1.0" encoding="utf-8"?><StorageServiceProperties><Logging><Version>1.0</Version></Logging><StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument></StaticWebsite></StorageServiceProperties>`
+	var properties storageStaticWebsiteServiceProperties
+	if err := xml.Unmarshal([]byte(response), &properties); err != nil {
+		t.Fatalf("Error unmarshalling: %+v", err)
+	}
+
+	if properties.StaticWebsite == nil || !properties.StaticWebsite.Enabled || properties.StaticWebsite.IndexDocument != "index.html" {
+		t.Fatalf("Expected the Static Website to be enabled with an Index Document but got %+v", properties.StaticWebsite)
+	}
+}
+
+func TestStorageStaticWebsiteClientSharedKeySignature(t *testing.T) {
+	authorizer, err := newStorageSharedKeyAuthorizer("example", "YXp1cmVhZA==")
+	if err != nil {
+		t.Fatalf("Error building the Authorizer: %+v", err)
+	}
+
+	requests := make([]*http.Request, 0)
+	client := newStorageStaticWebsiteClient("https://example.blob.core.windows.net")
+	client.Authorizer = authorizer
+	client.Sender = azure.DecorateSender(testStorageSignatureVerifier(t, authorizer, http.StatusAccepted, &requests), azure.DefaultRetryPolicy(), nil)
+
+	op := correlation.NewOperation()
+	contexts := []context.Context{
+		correlation.NewContext(context.Background(), op),
+		context.Background(),
+	}
+	for _, ctx := range contexts {
+		if err := client.Set(ctx, storageStaticWebsite{Enabled: true}); err != nil {
+			t.Fatalf("Error setting the Static Website: %+v", err)
+		}
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests but got %d", len(requests))
+	}
+
+	// the Client Request ID is only known when the request is part of an Operation
+	if actual := requests[0].Header.Get(correlation.ClientRequestIDHeader); actual != op.ClientRequestID {
+		t.Fatalf("Expected the Client Request ID to be %q but got %q", op.ClientRequestID, actual)
+	}
+	if actual := requests[1].Header.Get(correlation.ClientRequestIDHeader); actual != "" {
+		t.Fatalf("Expected no Client Request ID outside of an Operation but got %q", actual)
+	}
+}
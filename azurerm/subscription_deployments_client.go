@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// subscriptionDeploymentsApiVersion is the API version used for Template Deployments at the Subscription scope,
+// which aren't supported by the API version in the Resources SDK - as such these requests are made directly
+const subscriptionDeploymentsApiVersion = "2018-05-01"
+
+// subscriptionDeploymentsClient manages Template Deployments at the Subscription scope
+type subscriptionDeploymentsClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+// subscriptionDeployment is a Template Deployment at the Subscription scope - which (unlike a Template Deployment
+// within a Resource Group) requires a Location, which is where the Deployment's metadata is stored
+type subscriptionDeployment struct {
+	Location   *string                         `json:"location,omitempty"`
+	Properties *resources.DeploymentProperties `json:"properties,omitempty"`
+}
+
+type subscriptionDeploymentExtended struct {
+	autorest.Response `json:"-"`
+	ID                *string                                 `json:"id,omitempty"`
+	Name              *string                                 `json:"name,omitempty"`
+	Location          *string                                 `json:"location,omitempty"`
+	Properties        *resources.DeploymentPropertiesExtended `json:"properties,omitempty"`
+}
+
+func newSubscriptionDeploymentsClientWithBaseURI(baseURI string, subscriptionID string) subscriptionDeploymentsClient {
+	return subscriptionDeploymentsClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// CreateOrUpdate deploys the Template to the Subscription - returning a Future which completes once it's deployed
+func (client subscriptionDeploymentsClient) CreateOrUpdate(ctx context.Context, deploymentName string, parameters subscriptionDeployment) (azure.Future, error) {
+	req, err := client.prepare(ctx, deploymentName, "",
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+// Get retrieves the Template Deployment
+func (client subscriptionDeploymentsClient) Get(ctx context.Context, deploymentName string) (result subscriptionDeploymentExtended, err error) {
+	req, err := client.prepare(ctx, deploymentName, "", autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// ExportTemplate retrieves the Template used for the Template Deployment
+func (client subscriptionDeploymentsClient) ExportTemplate(ctx context.Context, deploymentName string) (result resources.DeploymentExportResult, err error) {
+	req, err := client.prepare(ctx, deploymentName, "/exportTemplate", autorest.AsPost())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "ExportTemplate", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "ExportTemplate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "ExportTemplate", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Validate validates whether the Template is syntactically correct and will be accepted by Azure Resource Manager -
+// where the validation errors are returned in the result (rather than as an error)
+func (client subscriptionDeploymentsClient) Validate(ctx context.Context, deploymentName string, parameters subscriptionDeployment) (result resources.DeploymentValidateResult, err error) {
+	req, err := client.prepare(ctx, deploymentName, "/validate",
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Validate", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Validate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Validate", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Delete deletes the Template Deployment (but not the resources deployed by it) - returning a Future which
+// completes once it's deleted
+func (client subscriptionDeploymentsClient) Delete(ctx context.Context, deploymentName string) (azure.Future, error) {
+	req, err := client.prepare(ctx, deploymentName, "", autorest.AsDelete())
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted, http.StatusNoContent))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.subscriptionDeploymentsClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+func (client subscriptionDeploymentsClient) prepare(ctx context.Context, deploymentName string, suffix string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName": autorest.Encode("path", deploymentName),
+		"subscriptionId": autorest.Encode("path", client.SubscriptionID),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": subscriptionDeploymentsApiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/Microsoft.Resources/deployments/{deploymentName}"+suffix, pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
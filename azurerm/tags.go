@@ -26,6 +26,15 @@ func tagsForceNewSchema() *schema.Schema {
 	}
 }
 
+// tagsAllSchema returns the schema for the `tags_all` attribute, which contains all of the tags assigned
+// to a resource - including those inherited from the `default_tags` block in the Provider
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
 func tagsForDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -79,6 +88,72 @@ func expandTags(tagsMap map[string]interface{}) map[string]*string {
 	return output
 }
 
+// expandTagsWithDefaults merges the `default_tags` defined in the Provider block into the specified tags,
+// where any tags defined on the resource take precedence
+func expandTagsWithDefaults(tagsMap map[string]interface{}, meta interface{}) map[string]*string {
+	return expandTags(mergeDefaultTags(tagsMap, meta))
+}
+
+func expandProviderDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+	for key, value := range v["tags"].(map[string]interface{}) {
+		// Validate should have ignored this error already
+		output[key], _ = tagValueToString(value)
+	}
+
+	return output
+}
+
+func mergeDefaultTags(tagsMap map[string]interface{}, meta interface{}) map[string]interface{} {
+	var defaultTags map[string]string
+	if client, ok := meta.(*ArmClient); ok {
+		defaultTags = client.defaultTags
+	}
+
+	output := make(map[string]interface{}, len(defaultTags)+len(tagsMap))
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	return output
+}
+
+// customizeDiffForTags computes the `tags_all` attribute from the resource's tags and the Provider's
+// `default_tags` - such that changes to the `default_tags` are applied to each resource
+func customizeDiffForTags(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tags := mergeDefaultTags(d.Get("tags").(map[string]interface{}), meta)
+	if _, errors := validateAzureRMTags(tags, "tags_all"); len(errors) > 0 {
+		return fmt.Errorf("Error validating the tags (including the `default_tags` from the Provider block): %+v", errors[0])
+	}
+
+	return d.SetNew("tags_all", tags)
+}
+
+// customizeDiffForTagsForceNew is customizeDiffForTags for resources where the tags can't be updated in-place
+func customizeDiffForTagsForceNew(d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffForTags(d, meta); err != nil {
+		return err
+	}
+
+	if d.HasChange("tags_all") {
+		return d.ForceNew("tags_all")
+	}
+
+	return nil
+}
+
 func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*string {
 	if len(tagNames) == 0 {
 		return tagsMap
@@ -114,3 +189,36 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// flattenAndSetTagsWithDefaults sets all of the tags assigned to the resource into `tags_all` - and any tags
+// which weren't inherited from the Provider's `default_tags` into `tags`, so that these don't show a diff.
+// Tags with the same value as a default tag are only kept in `tags` when they're also defined on the resource.
+func flattenAndSetTagsWithDefaults(d *schema.ResourceData, tagMap map[string]*string, meta interface{}) {
+	var defaultTags map[string]string
+	if client, ok := meta.(*ArmClient); ok {
+		defaultTags = client.defaultTags
+	}
+
+	existing := d.Get("tags").(map[string]interface{})
+
+	all := make(map[string]interface{}, len(tagMap))
+	tags := make(map[string]interface{}, len(tagMap))
+	for k, v := range tagMap {
+		if v == nil {
+			continue
+		}
+
+		all[k] = *v
+
+		if defaultValue, isDefault := defaultTags[k]; isDefault && defaultValue == *v {
+			if _, definedOnResource := existing[k]; !definedOnResource {
+				continue
+			}
+		}
+
+		tags[k] = *v
+	}
+
+	d.Set("tags", tags)
+	d.Set("tags_all", all)
+}
@@ -2,8 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -113,3 +118,205 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestExpandARMTagsWithDefaults(t *testing.T) {
+	meta := &ArmClient{
+		defaultTags: map[string]string{
+			"cost_center": "1234",
+			"environment": "production",
+		},
+	}
+
+	testData := map[string]interface{}{
+		"environment": "staging",
+		"owner":       "ops",
+	}
+
+	expanded := expandTagsWithDefaults(testData, meta)
+
+	expected := map[string]string{
+		"cost_center": "1234",
+		"environment": "staging",
+		"owner":       "ops",
+	}
+
+	if len(expanded) != len(expected) {
+		t.Fatalf("Expected %d results in expanded tag map, got %d", len(expected), len(expanded))
+	}
+
+	for k, v := range expected {
+		if actual := expanded[k]; actual == nil || *actual != v {
+			t.Fatalf("Expanded value %q incorrect: expected %q, got %v", k, v, actual)
+		}
+	}
+}
+
+func TestFlattenAndSetTagsWithDefaults(t *testing.T) {
+	meta := &ArmClient{
+		defaultTags: map[string]string{
+			"cost_center": "1234",
+			"environment": "production",
+			"owner":       "ops",
+		},
+	}
+
+	values := map[string]string{
+		"cost_center": "1234",
+		"environment": "staging",
+		"owner":       "ops",
+		"project":     "example",
+	}
+	apiTags := make(map[string]*string)
+	for k, v := range values {
+		value := v
+		apiTags[k] = &value
+	}
+
+	d := testResourceWithTags().TestResourceData()
+	d.Set("tags", map[string]interface{}{
+		"owner":   "ops",
+		"project": "example",
+	})
+
+	flattenAndSetTagsWithDefaults(d, apiTags, meta)
+
+	// `cost_center` is inherited from the default tags - `environment` overrides a default tag
+	// and `owner` is the same as the default tag, but is explicitly defined on the resource
+	expectedTags := map[string]interface{}{
+		"environment": "staging",
+		"owner":       "ops",
+		"project":     "example",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("Expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+
+	expectedAll := map[string]interface{}{
+		"cost_center": "1234",
+		"environment": "staging",
+		"owner":       "ops",
+		"project":     "example",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("Expected `tags_all` to be %+v but got %+v", expectedAll, actual)
+	}
+}
+
+func TestCustomizeDiffForTags(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		DefaultTags  map[string]string
+		Config       map[string]interface{}
+		State        map[string]string
+		ExpectedDiff bool
+	}{
+		{
+			Name: "New Resource",
+			DefaultTags: map[string]string{
+				"cost_center": "1234",
+			},
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"owner": "ops",
+				},
+			},
+			ExpectedDiff: true,
+		},
+		{
+			Name: "Inherited Tags Unchanged",
+			DefaultTags: map[string]string{
+				"cost_center": "1234",
+			},
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"owner": "ops",
+				},
+			},
+			State: map[string]string{
+				"id":                   "example",
+				"tags.%":               "1",
+				"tags.owner":           "ops",
+				"tags_all.%":           "2",
+				"tags_all.cost_center": "1234",
+				"tags_all.owner":       "ops",
+			},
+			ExpectedDiff: false,
+		},
+		{
+			Name: "Default Tag Changed",
+			DefaultTags: map[string]string{
+				"cost_center": "5678",
+			},
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"owner": "ops",
+				},
+			},
+			State: map[string]string{
+				"id":                   "example",
+				"tags.%":               "1",
+				"tags.owner":           "ops",
+				"tags_all.%":           "2",
+				"tags_all.cost_center": "1234",
+				"tags_all.owner":       "ops",
+			},
+			ExpectedDiff: true,
+		},
+		{
+			Name: "Default Tag Overridden",
+			DefaultTags: map[string]string{
+				"cost_center": "1234",
+			},
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"cost_center": "5678",
+				},
+			},
+			State: map[string]string{
+				"id":                   "example",
+				"tags.%":               "1",
+				"tags.cost_center":     "5678",
+				"tags_all.%":           "1",
+				"tags_all.cost_center": "5678",
+			},
+			ExpectedDiff: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		meta := &ArmClient{
+			defaultTags: v.DefaultTags,
+		}
+
+		var state *terraform.InstanceState
+		if v.State != nil {
+			state = &terraform.InstanceState{
+				ID:         v.State["id"],
+				Attributes: v.State,
+			}
+		}
+
+		diff, err := testResourceWithTags().Diff(state, terraform.NewResourceConfig(config.TestRawConfig(t, v.Config)), meta)
+		if err != nil {
+			t.Fatalf("Error computing the diff: %+v", err)
+		}
+
+		hasDiff := diff != nil && !diff.Empty()
+		if hasDiff != v.ExpectedDiff {
+			t.Fatalf("Expected a diff to be %t but got %t: %+v", v.ExpectedDiff, hasDiff, diff)
+		}
+	}
+}
+
+func testResourceWithTags() *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: customizeDiffForTags,
+
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// tagsUpdateFunc updates only the Tags assigned to a resource - using the service's PATCH/UpdateTags endpoint
+// where there's one, otherwise using updateTagsUsingResourcesClient
+type tagsUpdateFunc func(ctx context.Context, tags map[string]*string) error
+
+// isTagsOnlyUpdate determines whether the only change being made in an Update is to the `tags` (or to the
+// `tags_all`, when the Provider's `default_tags` have changed) - by checking each of the fields in the schema
+func isTagsOnlyUpdate(d *schema.ResourceData, resourceSchema map[string]*schema.Schema) bool {
+	if d.IsNewResource() || d.Id() == "" {
+		return false
+	}
+
+	if !d.HasChange("tags") && !d.HasChange("tags_all") {
+		return false
+	}
+
+	for key := range resourceSchema {
+		if key == "tags" || key == "tags_all" {
+			continue
+		}
+
+		if d.HasChange(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// updateTagsIfOnlyTagsChanged updates the Tags for a resource using the tagsUpdateFunc when they're the only
+// change being made - rather than sending the whole resource in a PUT, which can trigger a lengthy reprovision
+// and fails when other properties have drifted (or are locked). This returns whether the Tags were updated, in
+// which case the resource should be Read rather than being updated in full.
+func updateTagsIfOnlyTagsChanged(ctx context.Context, d *schema.ResourceData, meta interface{}, resourceSchema map[string]*schema.Schema, update tagsUpdateFunc) (bool, error) {
+	if !isTagsOnlyUpdate(d, resourceSchema) {
+		return false, nil
+	}
+
+	log.Printf("[DEBUG] Only the Tags have changed for %q - updating these in-place", d.Id())
+	tags := expandTagsWithDefaults(d.Get("tags").(map[string]interface{}), meta)
+	if err := update(ctx, tags); err != nil {
+		return true, fmt.Errorf("Error updating the Tags for %q: %+v", d.Id(), err)
+	}
+
+	return true, nil
+}
+
+// updateTagsUsingResourcesClient returns a tagsUpdateFunc which PATCH's the Tags for the specified Resource ID using
+// the generic Resources API, for services which don't offer a PATCH/UpdateTags endpoint. Since this API proxies the
+// request to the Resource Provider, the request is sent using the latest API version for the Resource Type.
+func updateTagsUsingResourcesClient(meta interface{}, resourceId string) tagsUpdateFunc {
+	return func(ctx context.Context, tags map[string]*string) error {
+		client := meta.(*ArmClient).resources()
+
+		providerNamespace, resourceType, err := resourceTypeFromID(resourceId)
+		if err != nil {
+			return err
+		}
+
+		provider, err := client.providersClient.Get(ctx, providerNamespace, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Resource Provider %q: %+v", providerNamespace, err)
+		}
+
+		apiVersion := latestApiVersionForResourceType(provider, resourceType)
+		if apiVersion == "" {
+			return fmt.Errorf("Unable to determine the API Version for Resource Type %q (Resource Provider %q)", resourceType, providerNamespace)
+		}
+
+		req, err := autorest.CreatePreparer(
+			autorest.AsContentType("application/json; charset=utf-8"),
+			autorest.AsPatch(),
+			autorest.WithBaseURL(client.resourcesClient.BaseURI),
+			autorest.WithPath(resourceId),
+			autorest.WithJSON(resources.GenericResource{
+				Tags: tags,
+			}),
+			autorest.WithQueryParameters(map[string]interface{}{
+				"api-version": apiVersion,
+			})).Prepare((&http.Request{}).WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("Error preparing the request: %+v", err)
+		}
+
+		future, err := client.resourcesClient.UpdateSender(req)
+		if err != nil {
+			return err
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.resourcesClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Tags to be updated: %+v", err)
+		}
+
+		if _, err = future.Result(client.resourcesClient); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// resourceTypeFromID returns the Resource Provider Namespace and Resource Type for the specified Resource ID,
+// e.g. `Microsoft.Sql` and `servers/databases` for a SQL Database
+func resourceTypeFromID(resourceId string) (string, string, error) {
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(resourceId, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	// an extension resource can be nested within another resource - in which case the last provider is used
+	providerIndex := -1
+	for i := 0; i+1 < len(segments); i += 2 {
+		if strings.EqualFold(segments[i], "providers") {
+			providerIndex = i
+		}
+	}
+
+	if providerIndex == -1 {
+		return "", "", fmt.Errorf("Resource ID %q doesn't contain a Resource Provider", resourceId)
+	}
+
+	types := make([]string, 0)
+	for i := providerIndex + 2; i+1 < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	if len(types) == 0 || (len(segments)-providerIndex)%2 != 0 {
+		return "", "", fmt.Errorf("Resource ID %q doesn't contain a Resource Type and Name", resourceId)
+	}
+
+	return segments[providerIndex+1], strings.Join(types, "/"), nil
+}
+
+// latestApiVersionForResourceType returns the latest stable API version for the Resource Type - or the latest
+// preview API version when no stable version is available. The API versions are returned newest first.
+func latestApiVersionForResourceType(provider resources.Provider, resourceType string) string {
+	if provider.ResourceTypes == nil {
+		return ""
+	}
+
+	for _, rt := range *provider.ResourceTypes {
+		if rt.ResourceType == nil || !strings.EqualFold(*rt.ResourceType, resourceType) || rt.APIVersions == nil {
+			continue
+		}
+
+		versions := *rt.APIVersions
+		for _, version := range versions {
+			if !strings.Contains(strings.ToLower(version), "preview") {
+				return version
+			}
+		}
+
+		if len(versions) > 0 {
+			return versions[0]
+		}
+	}
+
+	return ""
+}
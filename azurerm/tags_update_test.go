@@ -0,0 +1,237 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestIsTagsOnlyUpdate(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		DefaultTags map[string]string
+		Config      map[string]interface{}
+		Expected    bool
+	}{
+		{
+			Name: "Tags Changed",
+			Config: map[string]interface{}{
+				"sku": "Standard",
+				"tags": map[string]interface{}{
+					"owner": "devs",
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Default Tags Changed",
+			DefaultTags: map[string]string{
+				"cost_center": "1234",
+			},
+			Config: map[string]interface{}{
+				"sku": "Standard",
+				"tags": map[string]interface{}{
+					"owner": "ops",
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Tags and other fields Changed",
+			Config: map[string]interface{}{
+				"sku": "Premium",
+				"tags": map[string]interface{}{
+					"owner": "devs",
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Other fields Changed",
+			Config: map[string]interface{}{
+				"sku": "Premium",
+				"tags": map[string]interface{}{
+					"owner": "ops",
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		meta := &ArmClient{
+			defaultTags: v.DefaultTags,
+		}
+
+		state := &terraform.InstanceState{
+			ID: "example",
+			Attributes: map[string]string{
+				"id":             "example",
+				"sku":            "Standard",
+				"tags.%":         "1",
+				"tags.owner":     "ops",
+				"tags_all.%":     "1",
+				"tags_all.owner": "ops",
+			},
+		}
+
+		var actual *bool
+		resource := testResourceWithTagsAndSku()
+		resource.Update = func(d *schema.ResourceData, _ interface{}) error {
+			actual = utils.Bool(isTagsOnlyUpdate(d, resource.Schema))
+			return nil
+		}
+
+		diff, err := resource.Diff(state, terraform.NewResourceConfig(config.TestRawConfig(t, v.Config)), meta)
+		if err != nil {
+			t.Fatalf("Error computing the diff: %+v", err)
+		}
+
+		if _, err := resource.Apply(state, diff, meta); err != nil {
+			t.Fatalf("Error applying the diff: %+v", err)
+		}
+
+		if actual == nil {
+			t.Fatalf("Expected the resource to be updated but it wasn't")
+		}
+
+		if *actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, *actual)
+		}
+	}
+}
+
+func TestResourceTypeFromID(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		ID                string
+		ExpectedNamespace string
+		ExpectedType      string
+		ExpectError       bool
+	}{
+		{
+			Name:        "Resource Group",
+			ID:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ExpectError: true,
+		},
+		{
+			Name:        "Missing Name",
+			ID:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls",
+			ExpectError: true,
+		},
+		{
+			Name:              "Top Level Resource",
+			ID:                "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1",
+			ExpectedNamespace: "Microsoft.Network",
+			ExpectedType:      "azureFirewalls",
+		},
+		{
+			Name:              "Nested Resource",
+			ID:                "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+			ExpectedNamespace: "Microsoft.Sql",
+			ExpectedType:      "servers/databases",
+		},
+		{
+			Name:              "Extension Resource",
+			ID:                "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			ExpectedNamespace: "Microsoft.Insights",
+			ExpectedType:      "diagnosticSettings",
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		namespace, resourceType, err := resourceTypeFromID(v.ID)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if namespace != v.ExpectedNamespace {
+			t.Fatalf("Expected the Namespace to be %q but got %q", v.ExpectedNamespace, namespace)
+		}
+
+		if resourceType != v.ExpectedType {
+			t.Fatalf("Expected the Resource Type to be %q but got %q", v.ExpectedType, resourceType)
+		}
+	}
+}
+
+func TestLatestApiVersionForResourceType(t *testing.T) {
+	provider := resources.Provider{
+		ResourceTypes: &[]resources.ProviderResourceType{
+			{
+				ResourceType: utils.String("azureFirewalls"),
+				APIVersions:  &[]string{"2018-10-01-preview", "2018-08-01", "2018-07-01"},
+			},
+			{
+				ResourceType: utils.String("previewOnly"),
+				APIVersions:  &[]string{"2018-10-01-preview", "2018-09-01-preview"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		ResourceType string
+		Expected     string
+	}{
+		{
+			ResourceType: "azureFirewalls",
+			Expected:     "2018-08-01",
+		},
+		{
+			ResourceType: "AZUREFIREWALLS",
+			Expected:     "2018-08-01",
+		},
+		{
+			ResourceType: "previewOnly",
+			Expected:     "2018-10-01-preview",
+		},
+		{
+			ResourceType: "unknown",
+			Expected:     "",
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.ResourceType)
+
+		actual := latestApiVersionForResourceType(provider, v.ResourceType)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func testResourceWithTagsAndSku() *schema.Resource {
+	return &schema.Resource{
+		Read: func(_ *schema.ResourceData, _ interface{}) error {
+			return nil
+		},
+		CustomizeDiff: customizeDiffForTags,
+
+		Schema: map[string]*schema.Schema{
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
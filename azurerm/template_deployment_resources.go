@@ -0,0 +1,216 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// templateDeploymentOutputResourcesApiVersion is the API version used to retrieve the resources created by a
+// Template Deployment, since the `outputResources` aren't returned by the API version in the Resources SDK
+const templateDeploymentOutputResourcesApiVersion = "2018-05-01"
+
+type templateDeploymentWithOutputResources struct {
+	Properties *templateDeploymentPropertiesWithOutputResources `json:"properties,omitempty"`
+}
+
+type templateDeploymentPropertiesWithOutputResources struct {
+	OutputResources *[]templateDeploymentOutputResource `json:"outputResources,omitempty"`
+	Dependencies    *[]resources.Dependency             `json:"dependencies,omitempty"`
+}
+
+type templateDeploymentOutputResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// retrieveTemplateDeploymentOutputResources returns the ID's of the resources created by the Template Deployment,
+// ordered such that each resource is listed after the resources it depends on
+func retrieveTemplateDeploymentOutputResources(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string) ([]string, error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/Microsoft.Resources/deployments/{deploymentName}", map[string]interface{}{
+			"deploymentName":    autorest.Encode("path", name),
+			"resourceGroupName": autorest.Encode("path", resourceGroup),
+			"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": templateDeploymentOutputResourcesApiVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing the request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployment templateDeploymentWithOutputResources
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&deployment),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment.Properties == nil || deployment.Properties.OutputResources == nil {
+		return []string{}, nil
+	}
+
+	ids := make([]string, 0)
+	for _, resource := range *deployment.Properties.OutputResources {
+		if resource.ID != nil {
+			ids = append(ids, *resource.ID)
+		}
+	}
+
+	dependencies := make([]resources.Dependency, 0)
+	if deployment.Properties.Dependencies != nil {
+		dependencies = *deployment.Properties.Dependencies
+	}
+
+	return sortResourceIDsByDependencies(ids, dependencies), nil
+}
+
+// sortResourceIDsByDependencies orders the Resource ID's such that each resource is listed after the resources it
+// depends on - either explicitly (via the Template's `dependsOn`) or implicitly, since a child resource depends on
+// its parent. Resources which have no dependency on one another are ordered by their ID, so the order is stable.
+func sortResourceIDsByDependencies(ids []string, dependencies []resources.Dependency) []string {
+	// Resource ID's are case-insensitive, so these are compared in lower-case
+	keys := make(map[string]string)
+	for _, id := range ids {
+		keys[strings.ToLower(id)] = id
+	}
+
+	dependsOn := make(map[string]map[string]struct{})
+	for key := range keys {
+		dependsOn[key] = make(map[string]struct{})
+		for other := range keys {
+			if other != key && strings.HasPrefix(key, other+"/") {
+				dependsOn[key][other] = struct{}{}
+			}
+		}
+	}
+
+	for _, dependency := range dependencies {
+		if dependency.ID == nil || dependency.DependsOn == nil {
+			continue
+		}
+
+		key := strings.ToLower(*dependency.ID)
+		if _, ok := dependsOn[key]; !ok {
+			continue
+		}
+
+		for _, basic := range *dependency.DependsOn {
+			if basic.ID == nil {
+				continue
+			}
+
+			other := strings.ToLower(*basic.ID)
+			if _, ok := keys[other]; ok && other != key {
+				dependsOn[key][other] = struct{}{}
+			}
+		}
+	}
+
+	output := make([]string, 0)
+	for len(dependsOn) > 0 {
+		available := make([]string, 0)
+		for key, others := range dependsOn {
+			if len(others) == 0 {
+				available = append(available, key)
+			}
+		}
+
+		// the dependencies are cyclic - so the remaining resources are ordered by their ID
+		if len(available) == 0 {
+			for key := range dependsOn {
+				available = append(available, key)
+			}
+		}
+
+		sort.Strings(available)
+		for _, key := range available {
+			output = append(output, keys[key])
+			delete(dependsOn, key)
+		}
+
+		for _, others := range dependsOn {
+			for _, key := range available {
+				delete(others, key)
+			}
+		}
+	}
+
+	return output
+}
+
+// deleteResourceByID deletes the specified resource using the generic Resources API - which proxies the request
+// to the Resource Provider, as such the request is sent using the latest API version for the Resource Type
+func deleteResourceByID(ctx context.Context, meta interface{}, resourceId string) error {
+	client := meta.(*ArmClient).resources()
+
+	providerNamespace, resourceType, err := resourceTypeFromID(resourceId)
+	if err != nil {
+		return err
+	}
+
+	provider, err := client.providersClient.Get(ctx, providerNamespace, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Resource Provider %q: %+v", providerNamespace, err)
+	}
+
+	apiVersion := latestApiVersionForResourceType(provider, resourceType)
+	if apiVersion == "" {
+		return fmt.Errorf("Unable to determine the API Version for Resource Type %q (Resource Provider %q)", resourceType, providerNamespace)
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.resourcesClient.BaseURI),
+		autorest.WithPath(resourceId),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("Error preparing the request: %+v", err)
+	}
+
+	resp, err := client.resourcesClient.DeleteSender(req)
+	if err != nil {
+		return err
+	}
+
+	// the resource may have been deleted along with its parent, or outside of Terraform
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Resource %q was not found - assuming removed", resourceId)
+		return nil
+	}
+
+	if err = autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent)); err != nil {
+		return err
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.resourcesClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestSortResourceIDsByDependencies(t *testing.T) {
+	networkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	subnetId := networkId + "/subnets/subnet1"
+	nicId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1"
+	publicIpId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+	vmId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1"
+
+	testCases := []struct {
+		Name         string
+		IDs          []string
+		Dependencies []resources.Dependency
+		Expected     []string
+	}{
+		{
+			Name:     "No Resources",
+			IDs:      []string{},
+			Expected: []string{},
+		},
+		{
+			Name:     "No Dependencies",
+			IDs:      []string{publicIpId, nicId},
+			Expected: []string{nicId, publicIpId},
+		},
+		{
+			Name:     "Child Resource",
+			IDs:      []string{subnetId, networkId},
+			Expected: []string{networkId, subnetId},
+		},
+		{
+			Name: "Explicit Dependencies",
+			IDs:  []string{vmId, nicId, publicIpId, networkId},
+			Dependencies: []resources.Dependency{
+				{
+					ID: utils.String(vmId),
+					DependsOn: &[]resources.BasicDependency{
+						{
+							ID: utils.String(nicId),
+						},
+					},
+				},
+				{
+					// Resource ID's are case-insensitive
+					ID: utils.String(strings.ToUpper(nicId)),
+					DependsOn: &[]resources.BasicDependency{
+						{
+							ID: utils.String(publicIpId),
+						},
+						{
+							ID: utils.String(networkId),
+						},
+					},
+				},
+			},
+			Expected: []string{publicIpId, networkId, nicId, vmId},
+		},
+		{
+			Name: "Dependency which isn't an Output Resource",
+			IDs:  []string{nicId},
+			Dependencies: []resources.Dependency{
+				{
+					ID: utils.String(nicId),
+					DependsOn: &[]resources.BasicDependency{
+						{
+							ID: utils.String(networkId),
+						},
+					},
+				},
+			},
+			Expected: []string{nicId},
+		},
+		{
+			Name: "Cyclic Dependencies",
+			IDs:  []string{nicId, publicIpId},
+			Dependencies: []resources.Dependency{
+				{
+					ID: utils.String(nicId),
+					DependsOn: &[]resources.BasicDependency{
+						{
+							ID: utils.String(publicIpId),
+						},
+					},
+				},
+				{
+					ID: utils.String(publicIpId),
+					DependsOn: &[]resources.BasicDependency{
+						{
+							ID: utils.String(nicId),
+						},
+					},
+				},
+			},
+			Expected: []string{nicId, publicIpId},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := sortResourceIDsByDependencies(v.IDs, v.Dependencies)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// templateDeploymentValidationTimeout is how long the plan waits for Azure to validate the Template Deployment
+const templateDeploymentValidationTimeout = 5 * time.Minute
+
+// templateDeploymentFields are the fields which define the Template and Parameters for a Template Deployment
+var templateDeploymentFields = []string{"template_body", "template_link", "parameters", "parameters_body", "parameters_link"}
+
+// templateDeploymentRequiresValidation determines whether the Template Deployment should be validated by Azure during
+// the plan - which is the case when it's being deployed (since the Template or Parameters have changed) and all of the
+// values required to validate it are known
+func templateDeploymentRequiresValidation(d *schema.ResourceDiff, additionalFields ...string) bool {
+	fields := append(additionalFields, templateDeploymentFields...)
+
+	for _, field := range fields {
+		if !d.NewValueKnown(field) {
+			return false
+		}
+	}
+
+	if d.Id() == "" {
+		return true
+	}
+
+	for _, field := range templateDeploymentFields {
+		if d.HasChange(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diffTemplateDeploymentTemplate ensures exactly one of `template_body` or `template_link` is specified. Since
+// `template_body` is Computed it may still hold the previous Template when switching to a `template_link` (as the two
+// conflict it can't be specified in the configuration alongside the link), in which case it's cleared
+func diffTemplateDeploymentTemplate(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("template_body") || !d.NewValueKnown("template_link") {
+		return nil
+	}
+
+	if v, ok := d.GetOk("template_link"); ok && len(v.([]interface{})) > 0 {
+		if d.Get("template_body").(string) != "" {
+			return d.SetNew("template_body", "")
+		}
+
+		return nil
+	}
+
+	if d.Get("template_body").(string) == "" {
+		return fmt.Errorf("Exactly one of `template_body` or `template_link` must be specified")
+	}
+
+	return nil
+}
+
+// validateTemplateDeploymentParameterValues validates the values specified in the `parameters` or `parameters_body`
+// against the Parameters declared in the `template_body` - which can be done without making any requests
+func validateTemplateDeploymentParameterValues(d *schema.ResourceDiff) error {
+	for _, field := range []string{"template_body", "parameters", "parameters_body"} {
+		if !d.NewValueKnown(field) {
+			return nil
+		}
+	}
+
+	templateBody := d.Get("template_body").(string)
+	if templateBody == "" {
+		return nil
+	}
+
+	// the Template is retrieved from the link, so any `template_body` is from a previous deployment
+	if v, ok := d.GetOk("template_link"); ok && len(v.([]interface{})) > 0 {
+		return nil
+	}
+
+	// the Parameters aren't known until Azure retrieves them from the link
+	if v, ok := d.GetOk("parameters_link"); ok && len(v.([]interface{})) > 0 {
+		return nil
+	}
+
+	values := make(map[string]interface{})
+
+	if v, ok := d.GetOk("parameters"); ok {
+		for name, value := range v.(map[string]interface{}) {
+			values[name] = value
+		}
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return err
+		}
+
+		for name, param := range params {
+			// a Parameter may instead be a reference to a Key Vault Secret, which isn't validated
+			var value interface{}
+			if p, ok := param.(map[string]interface{}); ok {
+				value = p["value"]
+			}
+			values[name] = value
+		}
+	}
+
+	errors := validate.ArmTemplateParameterValues(templateBody, values)
+	if len(errors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0)
+	for _, err := range errors {
+		messages = append(messages, fmt.Sprintf("* %s", err))
+	}
+
+	return fmt.Errorf("The Parameters for the Template Deployment are invalid:\n%s", strings.Join(messages, "\n"))
+}
+
+// flattenTemplateDeploymentValidationError formats the error returned when validating the Template Deployment,
+// including the details of each error - which is where the useful information about the error is returned
+func flattenTemplateDeploymentValidationError(input resources.ManagementErrorWithDetails) string {
+	return strings.Join(flattenTemplateDeploymentValidationErrorDetails(input, ""), "\n")
+}
+
+func flattenTemplateDeploymentValidationErrorDetails(input resources.ManagementErrorWithDetails, indent string) []string {
+	code := ""
+	if input.Code != nil {
+		code = *input.Code
+	}
+
+	message := ""
+	if input.Message != nil {
+		message = *input.Message
+	}
+
+	output := []string{fmt.Sprintf("%s* %s: %s", indent, code, message)}
+
+	if input.Details != nil {
+		for _, detail := range *input.Details {
+			output = append(output, flattenTemplateDeploymentValidationErrorDetails(detail, indent+"  ")...)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,53 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenTemplateDeploymentValidationError(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    resources.ManagementErrorWithDetails
+		Expected string
+	}{
+		{
+			Name: "Single Error",
+			Input: resources.ManagementErrorWithDetails{
+				Code:    utils.String("InvalidTemplate"),
+				Message: utils.String("Deployment template validation failed."),
+			},
+			Expected: "* InvalidTemplate: Deployment template validation failed.",
+		},
+		{
+			Name: "Nested Details",
+			Input: resources.ManagementErrorWithDetails{
+				Code:    utils.String("InvalidTemplateDeployment"),
+				Message: utils.String("The template deployment is not valid."),
+				Details: &[]resources.ManagementErrorWithDetails{
+					{
+						Code:    utils.String("StorageAccountAlreadyTaken"),
+						Message: utils.String("The storage account named example is already taken."),
+						Details: &[]resources.ManagementErrorWithDetails{
+							{
+								Message: utils.String("Choose another name."),
+							},
+						},
+					},
+				},
+			},
+			Expected: "* InvalidTemplateDeployment: The template deployment is not valid.\n  * StorageAccountAlreadyTaken: The storage account named example is already taken.\n    * : Choose another name.",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenTemplateDeploymentValidationError(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
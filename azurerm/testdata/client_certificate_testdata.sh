@@ -0,0 +1,28 @@
+#!/bin/bash
+
+# This script creates the throwaway Client Certificate used in the unit tests
+# for authenticating as a Service Principal using a PKCS#12 bundle
+
+openssl req \
+    -newkey rsa:2048 \
+    -x509 \
+    -nodes \
+    -keyout client_certificate_test.key \
+    -new \
+    -out client_certificate_test.crt \
+    -subj /CN=terraform-client-certificate-test \
+    -sha256 \
+    -days 3650
+
+# golang.org/x/crypto/pkcs12 only supports the legacy (3DES) encryption algorithms
+openssl pkcs12 \
+    -export \
+    -out client_certificate_test.pfx \
+    -inkey client_certificate_test.key \
+    -in client_certificate_test.crt \
+    -keypbe PBE-SHA1-3DES \
+    -certpbe PBE-SHA1-3DES \
+    -macalg sha1 \
+    -password pass:terraform
+
+rm -f client_certificate_test.key client_certificate_test.crt
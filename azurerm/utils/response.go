@@ -13,6 +13,11 @@ func ResponseWasNotFound(resp autorest.Response) bool {
 
 func ResponseErrorIsRetryable(err error) bool {
 	if arerr, ok := err.(autorest.DetailedError); ok {
+		// requests which were throttled or failed with a transient error on the server side can be retried
+		if statusCode, ok := arerr.StatusCode.(int); ok && responseStatusCodeIsRetryable(statusCode) {
+			return true
+		}
+
 		err = arerr.Original
 	}
 
@@ -26,6 +31,20 @@ func ResponseErrorIsRetryable(err error) bool {
 	return false
 }
 
+func responseStatusCodeIsRetryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
 func responseWasStatusCode(resp autorest.Response, statusCode int) bool { // nolint: unparam
 	if r := resp.Response; r != nil {
 		if r.StatusCode == statusCode {
@@ -64,6 +64,12 @@ func TestResponseErrorIsRetryable(t *testing.T) {
 			Original: testNetError{true, true}}, true},
 		{"Unhandled error nested in autorest.DetailedError is not retryable", autorest.DetailedError{
 			Original: fmt.Errorf("Some other error")}, false},
+		{"Throttled requests are retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Too Many Requests"), StatusCode: http.StatusTooManyRequests}, true},
+		{"Transient server errors are retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Service Unavailable"), StatusCode: http.StatusServiceUnavailable}, true},
+		{"Client errors are not retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Bad Request"), StatusCode: http.StatusBadRequest}, false},
 		{"nil is handled as non-retryable", nil, false},
 	}
 
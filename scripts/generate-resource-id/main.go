@@ -0,0 +1,510 @@
+// generate-resource-id generates a typed Resource ID (with a Parser, Formatter and Validator) alongside
+// tests for it, from a Resource ID template. It's intended to be invoked via `go generate`, for example:
+//
+//	//go:generate go run ../../../scripts/generate-resource-id/main.go -name=Subnet -id=/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}
+//
+// Segment values wrapped in braces are placeholders which become fields on the generated struct, whereas
+// any other values are treated as fixed values which must be present in the ID being parsed.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const testSubscriptionId = "12345678-1234-9876-4563-123456789012"
+
+type segment struct {
+	// Key is the name of this segment in the Resource ID, e.g. `virtualNetworks`
+	Key string
+
+	// FieldName is the name of the field on the struct, when this segment is a placeholder
+	FieldName string
+
+	// ArgumentName is the name of the argument to the Constructor, when this segment is a placeholder
+	ArgumentName string
+
+	// FixedValue is the value which must be present for this segment, when it isn't a placeholder
+	FixedValue string
+
+	// ExampleValue is the value used for this segment in the generated tests
+	ExampleValue string
+
+	IsSubscription  bool
+	IsResourceGroup bool
+	IsProvider      bool
+}
+
+type resourceId struct {
+	Name     string
+	Template string
+	Segments []segment
+}
+
+func main() {
+	name := flag.String("name", "", "The name of the Resource Type, for example `Subnet`")
+	id := flag.String("id", "", "The Resource ID template, for example `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`")
+	path := flag.String("path", ".", "The directory the generated files should be written to")
+	fileName := flag.String("filename", "", "The name of the generated file (without the extension), defaults to the name in snake case")
+	flag.Parse()
+
+	if *name == "" || *id == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	if *fileName == "" {
+		*fileName = toSnakeCase(*name)
+	}
+
+	if err := run(*name, *id, *path, *fileName); err != nil {
+		log.Fatalf("Error generating Resource ID %q: %+v", *name, err)
+	}
+}
+
+func run(name, id, path, fileName string) error {
+	resource, err := parseTemplate(name, id)
+	if err != nil {
+		return err
+	}
+
+	filePath := fmt.Sprintf("%s/%s", strings.TrimSuffix(path, "/"), fileName)
+	if err := writeTemplate(fmt.Sprintf("%s.go", filePath), codeTemplate, resource); err != nil {
+		return err
+	}
+
+	return writeTemplate(fmt.Sprintf("%s_test.go", filePath), testTemplate, resource)
+}
+
+func parseTemplate(name, id string) (*resourceId, error) {
+	components := strings.Split(strings.Trim(id, "/"), "/")
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("expected an even number of segments in %q", id)
+	}
+
+	segments := make([]segment, 0)
+	for i := 0; i < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+		item := segment{
+			Key:             key,
+			IsSubscription:  i == 0 && key == "subscriptions",
+			IsResourceGroup: key == "resourceGroups",
+			IsProvider:      key == "providers",
+		}
+
+		if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+			placeholder := strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}")
+			item.ArgumentName = placeholder
+			item.FieldName = toFieldName(placeholder)
+			item.ExampleValue = exampleValue(item, name)
+		} else {
+			item.FixedValue = value
+			item.ExampleValue = value
+		}
+
+		if (item.IsSubscription || item.IsResourceGroup) && item.FieldName == "" {
+			return nil, fmt.Errorf("the %q segment must be a placeholder", key)
+		}
+		if item.IsProvider && item.FixedValue == "" {
+			return nil, fmt.Errorf("the `providers` segment must be a fixed value")
+		}
+
+		segments = append(segments, item)
+	}
+
+	if len(segments) < 2 || !segments[0].IsSubscription || !segments[1].IsResourceGroup {
+		return nil, fmt.Errorf("expected the ID to start with `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`")
+	}
+
+	return &resourceId{
+		Name:     name,
+		Template: id,
+		Segments: segments,
+	}, nil
+}
+
+func writeTemplate(fileName string, tmpl string, resource *resourceId) error {
+	t, err := template.New(fileName).Funcs(template.FuncMap{
+		"prefix":     resource.prefix,
+		"upper":      resource.upperCased,
+		"example":    resource.example,
+		"provider":   resource.provider,
+		"fieldNames": resource.fieldNames,
+		"arguments":  resource.arguments,
+	}).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("parsing template: %+v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, resource); err != nil {
+		return fmt.Errorf("executing template: %+v", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %q: %+v\n\n%s", fileName, err, buf.String())
+	}
+
+	return ioutil.WriteFile(fileName, formatted, 0644)
+}
+
+// FormatString returns the format string used to build this Resource ID
+func (r resourceId) FormatString() string {
+	components := make([]string, 0)
+	for _, v := range r.Segments {
+		value := "%s"
+		if v.FieldName == "" {
+			value = v.FixedValue
+		}
+		components = append(components, v.Key, value)
+	}
+	return "/" + strings.Join(components, "/")
+}
+
+// Fields returns the segments which are placeholders
+func (r resourceId) Fields() []segment {
+	fields := make([]segment, 0)
+	for _, v := range r.Segments {
+		if v.FieldName != "" {
+			fields = append(fields, v)
+		}
+	}
+	return fields
+}
+
+func (r resourceId) fieldNames(variable string) string {
+	names := make([]string, 0)
+	for _, v := range r.Fields() {
+		names = append(names, fmt.Sprintf("%s.%s", variable, v.FieldName))
+	}
+	return strings.Join(names, ", ")
+}
+
+func (r resourceId) arguments(quoted bool) string {
+	args := make([]string, 0)
+	for _, v := range r.Fields() {
+		if quoted {
+			args = append(args, fmt.Sprintf("%q", v.ExampleValue))
+		} else {
+			args = append(args, v.ArgumentName)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func (r resourceId) provider() string {
+	for _, v := range r.Segments {
+		if v.IsProvider {
+			return v.FixedValue
+		}
+	}
+	return ""
+}
+
+// prefix returns an example ID containing the first `count` segments
+func (r resourceId) prefix(count int) string {
+	components := make([]string, 0)
+	for _, v := range r.Segments[0:count] {
+		components = append(components, v.Key, v.ExampleValue)
+	}
+	if len(components) == 0 {
+		return ""
+	}
+	return "/" + strings.Join(components, "/")
+}
+
+// example returns a complete example ID
+func (r resourceId) example() string {
+	return r.prefix(len(r.Segments))
+}
+
+// upperCased returns a complete example ID where the segment names are upper-cased
+func (r resourceId) upperCased() string {
+	components := make([]string, 0)
+	for _, v := range r.Segments {
+		components = append(components, strings.ToUpper(v.Key), v.ExampleValue)
+	}
+	return "/" + strings.Join(components, "/")
+}
+
+func (s segment) Description() string {
+	if s.FieldName != "" {
+		return s.FieldName
+	}
+	if s.IsProvider {
+		return "Provider"
+	}
+	return s.Key
+}
+
+func exampleValue(s segment, resourceName string) string {
+	if s.IsSubscription {
+		return testSubscriptionId
+	}
+	if s.IsResourceGroup {
+		return "resGroup1"
+	}
+	if s.ArgumentName == "name" {
+		return strings.ToLower(resourceName) + "1"
+	}
+	return strings.TrimSuffix(s.ArgumentName, "Name") + "1"
+}
+
+func toFieldName(input string) string {
+	if input == "" {
+		return input
+	}
+
+	output := strings.ToUpper(input[0:1]) + input[1:]
+	if strings.HasSuffix(output, "Id") {
+		output = strings.TrimSuffix(output, "Id") + "ID"
+	}
+	return output
+}
+
+func toSnakeCase(input string) string {
+	runes := []rune(input)
+	var output bytes.Buffer
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				output.WriteRune('_')
+			}
+		}
+		output.WriteRune(unicode.ToLower(r))
+	}
+	return output.String()
+}
+
+const codeTemplate = `package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+{{- if or provider .HasFixedSegments }}
+	"strings"
+{{- end }}
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// {{ .Name }}ID is a parsed Resource ID for a {{ .Name }}
+type {{ .Name }}ID struct {
+{{- range .Fields }}
+	{{ .FieldName }} string
+{{- end }}
+}
+
+// New{{ .Name }}ID returns a {{ .Name }}ID comprised of the specified components
+func New{{ .Name }}ID({{ arguments false }} string) {{ .Name }}ID {
+	return {{ .Name }}ID{
+{{- range .Fields }}
+		{{ .FieldName }}: {{ .ArgumentName }},
+{{- end }}
+	}
+}
+
+// ID returns the Resource ID for this {{ .Name }}
+func (id {{ .Name }}ID) ID() string {
+	fmtString := {{ printf "%q" .FormatString }}
+	return fmt.Sprintf(fmtString, {{ fieldNames "id" }})
+}
+
+// Parse{{ .Name }}ID parses the specified Resource ID into a {{ .Name }}ID
+func Parse{{ .Name }}ID(input string) (*{{ .Name }}ID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a {{ .Name }} ID: %+v", input, err)
+	}
+{{- if provider }}
+
+	if !strings.EqualFold(id.Provider, {{ printf "%q" provider }}) {
+		return nil, fmt.Errorf("Error parsing %q as a {{ .Name }} ID: expected the Resource Provider %q but got %q", input, {{ printf "%q" provider }}, id.Provider)
+	}
+{{- else }}
+
+	if id.Provider != "" {
+		return nil, fmt.Errorf("Error parsing %q as a {{ .Name }} ID: expected no Resource Provider but got %q", input, id.Provider)
+	}
+{{- end }}
+
+	resourceId := {{ .Name }}ID{
+{{- range .Segments }}
+{{- if .IsSubscription }}
+		{{ .FieldName }}: id.SubscriptionID,
+{{- else if .IsResourceGroup }}
+		{{ .FieldName }}: id.ResourceGroup,
+{{- end }}
+{{- end }}
+	}
+{{- range .Segments }}
+{{- if not (or .IsSubscription .IsResourceGroup .IsProvider) }}
+{{- if .FieldName }}
+
+	if resourceId.{{ .FieldName }}, err = id.PopSegment({{ printf "%q" .Key }}); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a {{ $.Name }} ID: %+v", input, err)
+	}
+{{- else }}
+
+	if value, err := id.PopSegment({{ printf "%q" .Key }}); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a {{ $.Name }} ID: %+v", input, err)
+	} else if !strings.EqualFold(value, {{ printf "%q" .FixedValue }}) {
+		return nil, fmt.Errorf("Error parsing %q as a {{ $.Name }} ID: expected the ` + "`{{ .Key }}`" + ` segment to be %q but got %q", input, {{ printf "%q" .FixedValue }}, value)
+	}
+{{- end }}
+{{- end }}
+{{- end }}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a {{ .Name }} ID: %+v", input, err)
+	}
+
+	return &resourceId, nil
+}
+
+// Validate{{ .Name }}ID validates that the specified value is a {{ .Name }} ID
+func Validate{{ .Name }}ID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := Parse{{ .Name }}ID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a {{ .Name }} ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+`
+
+const testTemplate = `package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+)
+
+func Test{{ .Name }}IDFormatter(t *testing.T) {
+	actual := New{{ .Name }}ID({{ arguments true }}).ID()
+	expected := {{ printf "%q" example }}
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParse{{ .Name }}ID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *{{ .Name }}ID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+{{- range $i, $s := .Segments }}
+{{- if gt $i 0 }}
+		{
+			Name:     "Missing {{ $s.Description }}",
+			Input:    {{ printf "%q" (prefix $i) }},
+			Expected: nil,
+		},
+{{- end }}
+		{
+			Name:     "Missing {{ $s.Description }} Value",
+			Input:    "{{ prefix $i }}/{{ $s.Key }}/",
+			Expected: nil,
+		},
+{{- end }}
+		{
+			Name:  "{{ .Name }} ID",
+			Input: {{ printf "%q" example }},
+			Expected: &{{ .Name }}ID{
+{{- range .Fields }}
+				{{ .FieldName }}: {{ printf "%q" .ExampleValue }},
+{{- end }}
+			},
+		},
+		{
+			Name:  "Upper-cased Segment Names",
+			Input: {{ printf "%q" upper }},
+			Expected: &{{ .Name }}ID{
+{{- range .Fields }}
+				{{ .FieldName }}: {{ printf "%q" .ExampleValue }},
+{{- end }}
+			},
+		},
+		{
+			Name:     "Additional Segment",
+			Input:    "{{ example }}/additional/segment1",
+			Expected: nil,
+		},
+{{- if provider }}
+		{
+			Name:     "Different Resource Provider",
+			Input:    {{ printf "%q" .DifferentProviderExample }},
+			Expected: nil,
+		},
+{{- else }}
+		{
+			Name:     "Resource Provider",
+			Input:    "{{ example }}/providers/Microsoft.Network",
+			Expected: nil,
+		},
+{{- end }}
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := Parse{{ .Name }}ID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value: %+v", *actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+`
+
+// HasFixedSegments returns whether any segments (other than the Provider) have a fixed value
+func (r resourceId) HasFixedSegments() bool {
+	for _, v := range r.Segments {
+		if v.FieldName == "" && !v.IsProvider {
+			return true
+		}
+	}
+	return false
+}
+
+// DifferentProviderExample returns a complete example ID using a different Resource Provider
+func (r resourceId) DifferentProviderExample() string {
+	provider := r.provider()
+	return strings.Replace(r.example(), "/providers/"+provider+"/", "/providers/Microsoft.Different/", 1)
+}